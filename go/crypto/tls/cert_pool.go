@@ -8,8 +8,12 @@ import (
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 )
 
 // LoadX509CertificatePool returns loads a TLS x509.CertPool or update a TLS x509.CertPool if nil.
@@ -127,3 +131,90 @@ func of(certs ...any) []any {
 	}
 	return uniformedCerts
 }
+
+// LoadCertificatePoolAuto returns a TLS x509.CertPool loaded from input, sniffing what input is:
+// PEM encoded certificates if input starts with "-----BEGIN",
+// the path to a file of PEM encoded certificates if input names a readable file,
+// or else Base64 encoded (with or without padding) PEM encoded certificates.
+// Example: input="-----BEGIN CERTIFICATE-----\nMIIDZTCCAk2gAwIBAgIEV5xOtDANBgkqhkiG9w0BAQ0FADA0MTIwMAYDVQQDDClP..."
+// Example: input=~/cert.pem
+// Example: input="LS0tLS1CRUdJTiBDRVJUSUZJQ0FURS0tLS0tCk1JSUVFRENDQXZpZ0F3SUJBZ0lKQU5mK0lUMU1HaHhCTUEwR0NTcUdTSWI..."
+func LoadCertificatePoolAuto(input string) (*x509.CertPool, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return nil, ErrNoCertificatesConfigured
+	}
+
+	var pemBytes []byte
+	var kind string
+	if strings.HasPrefix(input, "-----BEGIN") {
+		pemBytes, kind = []byte(input), "PEM"
+	} else if fi, err := os.Stat(input); err == nil && fi.Mode().IsRegular() {
+		pemBytes, err = os.ReadFile(input)
+		if err != nil {
+			return nil, err
+		}
+		kind = "file " + input
+	} else {
+		pemBytes, err = base64.StdEncoding.DecodeString(input)
+		if err != nil {
+			pemBytes, err = base64.RawStdEncoding.DecodeString(input)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: neither PEM, a readable file nor base64 encoded: %v", ErrInvalidCertificateConfiguration, err)
+		}
+		kind = "base64 decoded PEM"
+	}
+
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(pemBytes) {
+		return nil, fmt.Errorf("%w: no valid certificates found in %s", ErrInvalidCertificateConfiguration, kind)
+	}
+	return certPool, nil
+}
+
+// certificateFileExts are the file extensions LoadCertificatePoolFromDir loads certificates from.
+var certificateFileExts = []string{".pem", ".crt", ".cer"}
+
+// LoadCertificatePoolFromDir returns a TLS x509.CertPool loaded from the PEM encoded certificates
+// in the files of dir with a certificate extension, that is .pem, .crt or .cer, like /etc/ssl/certs.
+// Only the top of dir is read, subdirectories are not walked; symbolic links are followed.
+// Files with a certificate extension that hold no valid certificates are skipped,
+// and reported as warnings joined into the error returned along with the pool,
+// each wrapping ErrInvalidCertificateConfiguration.
+// If no certificates were loaded at all, a nil pool and an error wrapping ErrNoCertificatesConfigured are returned.
+// Example: dir=/etc/ssl/certs
+func LoadCertificatePoolFromDir(dir string) (*x509.CertPool, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	certPool := x509.NewCertPool()
+	var loaded bool
+	var warnings []error
+	for _, entry := range entries {
+		ext := strings.ToLower(filepath.Ext(entry.Name()))
+		if !slices.Contains(certificateFileExts, ext) {
+			continue
+		}
+		name := filepath.Join(dir, entry.Name())
+		if fi, err := os.Stat(name); err != nil || !fi.Mode().IsRegular() {
+			continue
+		}
+		pemBytes, err := os.ReadFile(name)
+		if err != nil {
+			warnings = append(warnings, fmt.Errorf("%w: skip file %s: %w", ErrInvalidCertificateConfiguration, name, err))
+			continue
+		}
+		if !certPool.AppendCertsFromPEM(pemBytes) {
+			warnings = append(warnings, fmt.Errorf("%w: skip file %s: no valid certificates found", ErrInvalidCertificateConfiguration, name))
+			continue
+		}
+		loaded = true
+	}
+	if !loaded {
+		return nil, errors.Join(append([]error{fmt.Errorf("%w: in dir %s", ErrNoCertificatesConfigured, dir)}, warnings...)...)
+	}
+	return certPool, errors.Join(warnings...)
+}
@@ -5,7 +5,11 @@
 package tls_test
 
 import (
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/searKing/golang/go/crypto/tls"
@@ -98,3 +102,117 @@ func TestLoadX509CertificatePool(t *testing.T) {
 		t.Error(msg)
 	}
 }
+
+func TestLoadCertificatePoolAuto(t *testing.T) {
+	certPath := filepath.Join(t.TempDir(), "cert.pem")
+	_ = os.WriteFile(certPath, []byte(certFileContent), 0600)
+	garbagePath := filepath.Join(t.TempDir(), "garbage.pem")
+	_ = os.WriteFile(garbagePath, []byte("garbage"), 0600)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+	}{
+		{"empty", "", tls.ErrNoCertificatesConfigured},
+		{"blank", " \n", tls.ErrNoCertificatesConfigured},
+		{"PEM", certFileContent, nil},
+		{"PEM with spaces around", "\n" + certFileContent + "\n", nil},
+		{"file", certPath, nil},
+		{"base64", certFixture, nil},
+		{"base64 without padding", strings.TrimRight(certFixture, "="), nil},
+		{"invalid PEM", "-----BEGIN CERTIFICATE-----\ngarbage\n-----END CERTIFICATE-----", tls.ErrInvalidCertificateConfiguration},
+		{"invalid file", garbagePath, tls.ErrInvalidCertificateConfiguration},
+		{"missing file", filepath.Join(t.TempDir(), "missing.pem"), tls.ErrInvalidCertificateConfiguration},
+		{"invalid base64", "{}", tls.ErrInvalidCertificateConfiguration},
+		{"base64 of no PEM", "Z2FyYmFnZQ==", tls.ErrInvalidCertificateConfiguration},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %s", i, tt.name), func(t *testing.T) {
+			certPool, err := tls.LoadCertificatePoolAuto(tt.input)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("LoadCertificatePoolAuto(%q): got: _, %v exp: _, %v", tt.input, err, tt.wantErr)
+				}
+				if certPool != nil {
+					t.Errorf("LoadCertificatePoolAuto(%q): got: %v exp: nil", tt.input, certPool)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadCertificatePoolAuto(%q): got: _, %v exp: _, nil", tt.input, err)
+			}
+			want, err := tls.LoadX509CertificatePool(nil, "", certPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !certPool.Equal(want) {
+				t.Errorf("LoadCertificatePoolAuto(%q): got a pool other than loaded from %s", tt.input, certPath)
+			}
+		})
+	}
+}
+
+func TestLoadCertificatePoolFromDir(t *testing.T) {
+	certPath := filepath.Join(t.TempDir(), "cert.pem")
+	_ = os.WriteFile(certPath, []byte(certFileContent), 0600)
+	want, err := tls.LoadX509CertificatePool(nil, "", certPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	writeFiles := func(t *testing.T, files map[string]string) string {
+		dir := t.TempDir()
+		for name, content := range files {
+			path := filepath.Join(dir, name)
+			_ = os.MkdirAll(filepath.Dir(path), 0700)
+			_ = os.WriteFile(path, []byte(content), 0600)
+		}
+		return dir
+	}
+
+	tests := []struct {
+		name      string
+		files     map[string]string
+		wantPool  bool
+		wantErrs  []error
+		wantInErr []string
+	}{
+		{"empty", nil, false, []error{tls.ErrNoCertificatesConfigured}, nil},
+		{"valid", map[string]string{"a.pem": certFileContent, "b.CRT": certFileContent}, true, nil, nil},
+		{"non-cert files ignored", map[string]string{"a.cer": certFileContent, "README.txt": "garbage", "sub/b.pem": "garbage"}, true, nil, nil},
+		{"invalid skipped", map[string]string{"a.pem": certFileContent, "bad.crt": "garbage"},
+			true, []error{tls.ErrInvalidCertificateConfiguration}, []string{"bad.crt"}},
+		{"all invalid", map[string]string{"bad.pem": "garbage", "notes.txt": "garbage"},
+			false, []error{tls.ErrNoCertificatesConfigured, tls.ErrInvalidCertificateConfiguration}, []string{"bad.pem"}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %s", i, tt.name), func(t *testing.T) {
+			dir := writeFiles(t, tt.files)
+			certPool, err := tls.LoadCertificatePoolFromDir(dir)
+			if tt.wantPool && !certPool.Equal(want) {
+				t.Errorf("LoadCertificatePoolFromDir(%q): got a pool other than loaded from %s", dir, certPath)
+			}
+			if !tt.wantPool && certPool != nil {
+				t.Errorf("LoadCertificatePoolFromDir(%q): got: %v exp: nil", dir, certPool)
+			}
+			if len(tt.wantErrs) == 0 && err != nil {
+				t.Errorf("LoadCertificatePoolFromDir(%q): got: _, %v exp: _, nil", dir, err)
+			}
+			for _, wantErr := range tt.wantErrs {
+				if !errors.Is(err, wantErr) {
+					t.Errorf("LoadCertificatePoolFromDir(%q): got: _, %v exp: _, %v", dir, err, wantErr)
+				}
+			}
+			for _, s := range tt.wantInErr {
+				if err == nil || !strings.Contains(err.Error(), s) {
+					t.Errorf("LoadCertificatePoolFromDir(%q): got: _, %v exp: error mentioning %s", dir, err, s)
+				}
+			}
+		})
+	}
+
+	if _, err := tls.LoadCertificatePoolFromDir(filepath.Join(t.TempDir(), "missing")); err == nil {
+		t.Errorf("LoadCertificatePoolFromDir(missing): got: _, nil exp: _, error")
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"crypto/tls"
+	"fmt"
+	"strings"
+)
+
+// SecureCipherSuites returns the cipher suites recommended for TLS 1.2,
+// ECDHE key exchanges with AEAD ciphers only, which are forward secret and free of CBC and RC4.
+// TLS 1.3 suites are not configurable in crypto/tls, so none is listed.
+// The returned slice is a copy, free to be modified.
+func SecureCipherSuites() []uint16 {
+	return []uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256,
+	}
+}
+
+// NegotiatedSummary returns a one-line description of the parameters negotiated by a TLS handshake,
+// the version, the cipher suite and the ALPN protocol, for logging,
+// such as "version=TLS 1.3 cipher=TLS_AES_128_GCM_SHA256 alpn=h2".
+func NegotiatedSummary(state tls.ConnectionState) string {
+	if !state.HandshakeComplete {
+		return "handshake incomplete"
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "version=%s cipher=%s", tls.VersionName(state.Version), tls.CipherSuiteName(state.CipherSuite))
+	if state.NegotiatedProtocol != "" {
+		fmt.Fprintf(&b, " alpn=%s", state.NegotiatedProtocol)
+	}
+	if state.ServerName != "" {
+		fmt.Fprintf(&b, " sni=%s", state.ServerName)
+	}
+	if state.DidResume {
+		b.WriteString(" resumed")
+	}
+	return b.String()
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls_test
+
+import (
+	"crypto/tls"
+	"strings"
+	"testing"
+
+	tls_ "github.com/searKing/golang/go/crypto/tls"
+)
+
+func TestSecureCipherSuites(t *testing.T) {
+	insecure := make(map[uint16]bool)
+	for _, s := range tls.InsecureCipherSuites() {
+		insecure[s.ID] = true
+	}
+	suites := tls_.SecureCipherSuites()
+	if len(suites) == 0 {
+		t.Fatalf("SecureCipherSuites(): got none")
+	}
+	for _, id := range suites {
+		name := tls.CipherSuiteName(id)
+		if insecure[id] {
+			t.Errorf("SecureCipherSuites(): got insecure %s", name)
+		}
+		if strings.Contains(name, "_CBC_") || strings.Contains(name, "RC4") || strings.HasSuffix(name, "_SHA") {
+			t.Errorf("SecureCipherSuites(): got weak %s", name)
+		}
+		if !strings.HasPrefix(name, "TLS_ECDHE_") {
+			t.Errorf("SecureCipherSuites(): got %s not forward secret", name)
+		}
+	}
+
+	suites[0] = 0
+	if tls_.SecureCipherSuites()[0] == 0 {
+		t.Errorf("SecureCipherSuites(): got a shared slice, want a copy")
+	}
+}
+
+func TestNegotiatedSummary(t *testing.T) {
+	tests := []struct {
+		state tls.ConnectionState
+		want  string
+	}{
+		{tls.ConnectionState{}, "handshake incomplete"},
+		{tls.ConnectionState{
+			HandshakeComplete:  true,
+			Version:            tls.VersionTLS13,
+			CipherSuite:        tls.TLS_AES_128_GCM_SHA256,
+			NegotiatedProtocol: "h2",
+			ServerName:         "example.com",
+			DidResume:          true,
+		}, "version=TLS 1.3 cipher=TLS_AES_128_GCM_SHA256 alpn=h2 sni=example.com resumed"},
+		{tls.ConnectionState{
+			HandshakeComplete: true,
+			Version:           tls.VersionTLS12,
+			CipherSuite:       tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+		}, "version=TLS 1.2 cipher=TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384"},
+	}
+	for i, tt := range tests {
+		if got := tls_.NegotiatedSummary(tt.state); got != tt.want {
+			t.Errorf("#%d: NegotiatedSummary(): got %q, want %q", i, got, tt.want)
+		}
+	}
+}
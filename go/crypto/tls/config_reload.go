@@ -0,0 +1,180 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package tls
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// NewReloadableTLSConfig returns a TLS config for a server, serving the certificate of certFile and keyFile, and trusting
+// the certificates of caFile for client certificates, reloaded from the files on each SIGHUP until ctx is done,
+// so that a long-running server picks up renewed certificates without a restart, such as by
+// `kill -HUP <pid>` after the files are replaced.
+//
+// The certificate is served by GetCertificate. The CA pool is reloaded only if caFile changed,
+// by its size or modification time, and is trusted as ClientCAs of the config GetConfigForClient returns
+// for each handshake. An empty caFile loads no CA pool.
+// Set ClientAuth on the config returned to ask for client certificates, as with any tls.Config.
+// See NewReloadableClientTLSConfig for the config of a client.
+//
+// The files are loaded once before NewReloadableTLSConfig returns, failing on any error.
+// A reload failing later, such as on a file half written, keeps the previous certificate and CA pool,
+// and is logged by log/slog.
+// SIGHUP is received by os/signal.Notify, so that it no longer terminates the process, until ctx is done.
+func NewReloadableTLSConfig(ctx context.Context, certFile, keyFile, caFile string) (*tls.Config, error) {
+	r, err := newReloadableConfig(ctx, certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return r.cert.Load(), nil
+		},
+	}
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		c := config.Clone()
+		c.ClientCAs = r.caPool.Load()
+		return c, nil
+	}
+	return config, nil
+}
+
+// NewReloadableClientTLSConfig returns a TLS config for a client, presenting the certificate of certFile and keyFile,
+// and trusting the certificates of caFile for the server certificate, reloaded from the files on each SIGHUP
+// until ctx is done, as NewReloadableTLSConfig does.
+//
+// The certificate is presented by GetClientCertificate. The server certificate is verified by VerifyConnection
+// against the CA pool loaded last, for serverName, which is sent as ServerName too, and at Time of the config returned,
+// if set. InsecureSkipVerify is set so that RootCAs, fixed once the config is in use, are not verified against instead,
+// so do not unset VerifyConnection, which would leave the server unverified.
+// An empty caFile loads no CA pool, trusting the system roots.
+// serverName is required, a host name or an IP address, failing with ErrInvalidCertificateConfiguration if empty,
+// as the host dialed is not known to VerifyConnection, such as when the server sends no name for an IP address.
+func NewReloadableClientTLSConfig(ctx context.Context, certFile, keyFile, caFile, serverName string) (*tls.Config, error) {
+	if serverName == "" {
+		return nil, fmt.Errorf("%w: no server name to verify the server certificate for", ErrInvalidCertificateConfiguration)
+	}
+	r, err := newReloadableConfig(ctx, certFile, keyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		ServerName: serverName,
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return r.cert.Load(), nil
+		},
+		// verified by VerifyConnection against the CA pool loaded last instead
+		InsecureSkipVerify: true,
+	}
+	config.VerifyConnection = func(cs tls.ConnectionState) error {
+		return r.verifyServer(cs, serverName, config.Time)
+	}
+	return config, nil
+}
+
+// newReloadableConfig loads the files, and reloads them on each SIGHUP until ctx is done.
+func newReloadableConfig(ctx context.Context, certFile, keyFile, caFile string) (*reloadableConfig, error) {
+	r := &reloadableConfig{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+	go func() {
+		defer signal.Stop(sighup)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sighup:
+				if err := r.reload(); err != nil {
+					slog.Error("tls: reload certificates on SIGHUP, keep the previous ones",
+						slog.String("cert_file", certFile), slog.String("ca_file", caFile), slog.Any("error", err))
+				}
+			}
+		}
+	}()
+	return r, nil
+}
+
+// reloadableConfig holds the certificate and the CA pool loaded last by reload.
+type reloadableConfig struct {
+	certFile, keyFile, caFile string
+
+	cert   atomic.Pointer[tls.Certificate]
+	caPool atomic.Pointer[x509.CertPool]
+
+	// the modification time and size of caFile when loaded last, touched by reload only
+	caModTime time.Time
+	caSize    int64
+}
+
+// reload loads the certificate, and the CA pool if caFile changed since loaded last,
+// replacing both of them, or none on error.
+func (r *reloadableConfig) reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("unable to load X509 key pair from files: %w", err)
+	}
+	if r.caFile == "" {
+		r.cert.Store(&cert)
+		return nil
+	}
+
+	fi, err := os.Stat(r.caFile)
+	if err != nil {
+		return err
+	}
+	if r.caPool.Load() != nil && fi.ModTime().Equal(r.caModTime) && fi.Size() == r.caSize {
+		r.cert.Store(&cert)
+		return nil
+	}
+	pemBytes, err := os.ReadFile(r.caFile)
+	if err != nil {
+		return err
+	}
+	caPool := x509.NewCertPool()
+	if !caPool.AppendCertsFromPEM(pemBytes) {
+		return fmt.Errorf("%w: no valid certificates found in file %s", ErrInvalidCertificateConfiguration, r.caFile)
+	}
+	r.cert.Store(&cert)
+	r.caPool.Store(caPool)
+	r.caModTime, r.caSize = fi.ModTime(), fi.Size()
+	return nil
+}
+
+// verifyServer verifies the certificate chain of the server against the CA pool loaded last, for serverName,
+// at the time now returns, or the current time if now is nil, as a client does if InsecureSkipVerify is not set.
+func (r *reloadableConfig) verifyServer(cs tls.ConnectionState, serverName string, now func() time.Time) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("tls: server presented no certificates")
+	}
+	opts := x509.VerifyOptions{
+		Roots:         r.caPool.Load(),
+		DNSName:       serverName,
+		Intermediates: x509.NewCertPool(),
+	}
+	if now != nil {
+		opts.CurrentTime = now()
+	}
+	for _, cert := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := cs.PeerCertificates[0].Verify(opts)
+	return err
+}
@@ -0,0 +1,216 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build unix
+
+package tls_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	tls_ "crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/searKing/golang/go/crypto/tls"
+)
+
+// writeTestCertificate writes a self-signed certificate for localhost, usable by both servers and clients,
+// and its key to certFile and keyFile, and returns the certificate.
+func writeTestCertificate(t *testing.T, certFile, keyFile string, serial int64) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// handshake runs a mutual TLS handshake between server and client over a loopback connection.
+func handshake(t *testing.T, server, client *tls_.Config) error {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	errc := make(chan error, 1)
+	go func() {
+		c, err := l.Accept()
+		if err != nil {
+			errc <- err
+			return
+		}
+		defer c.Close()
+		errc <- tls_.Server(c, server).Handshake()
+	}()
+	c, err := tls_.Dial("tcp", l.Addr().String(), client)
+	if err == nil {
+		// read for the alert of a server rejecting the client certificate, sent after the client handshake in TLS 1.3
+		_ = c.SetReadDeadline(time.Now().Add(time.Second))
+		_, _ = c.Read(make([]byte, 1))
+		_ = c.Close()
+	}
+	if serr := <-errc; serr != nil {
+		return serr
+	}
+	return err
+}
+
+func TestNewReloadableTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	// the certificate is self-signed, so it is the CA of its own
+	caFile := certFile
+
+	certA := writeTestCertificate(t, certFile, keyFile, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	config, err := tls.NewReloadableTLSConfig(ctx, certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("NewReloadableTLSConfig() error = %v", err)
+	}
+	config.ClientAuth = tls_.RequireAndVerifyClientCert
+	client, err := tls.NewReloadableClientTLSConfig(ctx, certFile, keyFile, caFile, "localhost")
+	if err != nil {
+		t.Fatalf("NewReloadableClientTLSConfig() error = %v", err)
+	}
+
+	served := func() *x509.Certificate {
+		cert, err := config.GetCertificate(&tls_.ClientHelloInfo{})
+		if err != nil {
+			t.Fatalf("GetCertificate() error = %v", err)
+		}
+		return cert.Leaf
+	}
+	presented := func() *x509.Certificate {
+		cert, err := client.GetClientCertificate(&tls_.CertificateRequestInfo{})
+		if err != nil {
+			t.Fatalf("GetClientCertificate() error = %v", err)
+		}
+		return cert.Leaf
+	}
+	if got := served(); !got.Equal(certA) {
+		t.Fatalf("GetCertificate() serves serial %v, want %v", got.SerialNumber, certA.SerialNumber)
+	}
+	if err := handshake(t, config, client); err != nil {
+		t.Fatalf("handshake with certificate A: %v", err)
+	}
+
+	// a client keeping certificate A has to be rejected once the CA is reloaded
+	keyPairA, err := tls_.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certB := writeTestCertificate(t, certFile, keyFile, 2)
+	// make sure the CA file is seen as changed, even on a coarse modification time
+	future := time.Now().Add(time.Minute)
+	if err := os.Chtimes(caFile, future, future); err != nil {
+		t.Fatal(err)
+	}
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for !served().Equal(certB) || !presented().Equal(certB) {
+		if time.Now().After(deadline) {
+			t.Fatalf("GetCertificate() serves serial %v and GetClientCertificate() presents serial %v after SIGHUP, want %v",
+				served().SerialNumber, presented().SerialNumber, certB.SerialNumber)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := handshake(t, config, client); err != nil {
+		t.Fatalf("handshake with certificate B: %v", err)
+	}
+	stale := client.Clone()
+	stale.GetClientCertificate = func(*tls_.CertificateRequestInfo) (*tls_.Certificate, error) {
+		return &keyPairA, nil
+	}
+	if err := handshake(t, config, stale); err == nil {
+		t.Fatalf("handshake with client certificate A succeeded after the CA is reloaded")
+	}
+}
+
+func TestNewReloadableClientTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	// the certificate is self-signed for localhost, so it is the CA of its own
+	caFile := certFile
+	writeTestCertificate(t, certFile, keyFile, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	server, err := tls.NewReloadableTLSConfig(ctx, certFile, keyFile, caFile)
+	if err != nil {
+		t.Fatalf("NewReloadableTLSConfig() error = %v", err)
+	}
+
+	if _, err := tls.NewReloadableClientTLSConfig(ctx, certFile, keyFile, caFile, ""); !errors.Is(err, tls.ErrInvalidCertificateConfiguration) {
+		t.Fatalf("NewReloadableClientTLSConfig() with no server name error = %v, want %v", err, tls.ErrInvalidCertificateConfiguration)
+	}
+
+	tests := []struct {
+		name       string
+		serverName string
+		time       func() time.Time
+		wantErr    bool
+	}{
+		{name: "localhost", serverName: "localhost"},
+		// the server sends no name for an IP address, and the certificate has no IP address
+		{name: "ip", serverName: "127.0.0.1", wantErr: true},
+		{name: "mismatch", serverName: "other.example", wantErr: true},
+		{name: "expired", serverName: "localhost", time: func() time.Time { return time.Now().Add(24 * time.Hour) }, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := tls.NewReloadableClientTLSConfig(ctx, certFile, keyFile, caFile, tt.serverName)
+			if err != nil {
+				t.Fatalf("NewReloadableClientTLSConfig() error = %v", err)
+			}
+			client.Time = tt.time
+			if err := handshake(t, server, client); (err != nil) != tt.wantErr {
+				t.Errorf("handshake() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
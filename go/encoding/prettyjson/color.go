@@ -0,0 +1,67 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prettyjson
+
+import "bytes"
+
+// ColorTheme holds the ANSI escape sequences IndentColored colors the tokens of JSON with, such as "\x1b[32m".
+// A token whose sequence is empty is not colored, so that the zero value colors nothing.
+type ColorTheme struct {
+	Key    string // the keys of the members of objects
+	String string // string values
+	Number string // numbers
+	Bool   string // true and false
+	Null   string // null
+}
+
+// colorReset is the ANSI escape sequence appended after each token colored, restoring the default color.
+const colorReset = "\x1b[0m"
+
+var (
+	// DefaultColorTheme colors keys in bold blue, strings in green, numbers in cyan,
+	// booleans in yellow and null in gray, as terminals with a dark or light background display legibly.
+	DefaultColorTheme = ColorTheme{
+		Key:    "\x1b[1;34m",
+		String: "\x1b[32m",
+		Number: "\x1b[36m",
+		Bool:   "\x1b[33m",
+		Null:   "\x1b[90m",
+	}
+
+	// NoColorTheme colors nothing, such as when the output is not a terminal,
+	// so that IndentColored formats as Indent does.
+	NoColorTheme = ColorTheme{}
+)
+
+// color returns the escape sequence of the literal beginning with c, a key if key is set.
+func (t ColorTheme) color(c byte, key bool) string {
+	switch {
+	case key:
+		return t.Key
+	case c == '"':
+		return t.String
+	case c == 't' || c == 'f':
+		return t.Bool
+	case c == 'n':
+		return t.Null
+	default:
+		return t.Number
+	}
+}
+
+// IndentColored appends to dst an indented form of the JSON-encoded src, as Indent does,
+// with keys, string values, numbers, booleans and null colored by the ANSI escape sequences of theme,
+// each token followed by a reset of the color, such as for a CLI printing JSON to a terminal.
+// Pass NoColorTheme when the output is not a terminal.
+// The output is meant to be displayed, it is valid JSON only if theme colors nothing.
+func IndentColored(dst *bytes.Buffer, src []byte, prefix, indent string, theme ColorTheme) error {
+	dst.Grow(indentGrowthFactor * len(src))
+	b := dst.AvailableBuffer()
+	ind := newIndenter(prefix, indent)
+	ind.theme = theme
+	b, err := ind.appendIndent(b, src)
+	dst.Write(b)
+	return err
+}
@@ -0,0 +1,248 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prettyjson
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// The markers IndentDiff begins each line with, followed by a space.
+const (
+	DiffMarkSame    = ' ' // the value is the same in both documents, or is an object or array holding changes
+	DiffMarkAdded   = '+' // the value is in b only
+	DiffMarkRemoved = '-' // the value is in a only, shown after the members or elements of b
+	DiffMarkChanged = '~' // the value in b differs from the one in a at the same path
+)
+
+// IndentDiff appends to dst an indented form of the JSON-encoded b, as Indent does, with each line
+// beginning with a marker of how the value on it differs from the value at the same path in the JSON-encoded a,
+// one of DiffMarkSame, DiffMarkAdded, DiffMarkRemoved or DiffMarkChanged, and a space, such as for a diff tool.
+// Prefix and indentation follow the marker.
+//
+// Objects are aligned by the keys of their members, in the order of b, the members of a missing from b
+// are shown after them. Arrays are compared element by element, at the same index.
+// Scalar values are compared by their JSON text, so that 1 and 1.0 differ, while keys are compared decoded.
+// Keys and scalar values are shown as they are in the source, escaped or not.
+// The output is meant to be displayed, it is not valid JSON.
+func IndentDiff(dst *bytes.Buffer, a, b []byte, prefix, indent string) error {
+	scan := newScanner()
+	defer freeScanner(scan)
+	for _, src := range [][]byte{a, b} {
+		if err := checkValid(src, scan); err != nil {
+			return err
+		}
+	}
+	va, err := parseValue(a)
+	if err != nil {
+		return err
+	}
+	vb, err := parseValue(b)
+	if err != nil {
+		return err
+	}
+
+	p := diffPrinter{prefix: prefix, indent: indent}
+	p.diff(0, nil, va, vb, false)
+	dst.Write(p.dst)
+	return nil
+}
+
+// jsonValue is a JSON value parsed by parseValue, for IndentDiff and IndentSorted,
+// keeping the text of scalars and keys as they are in the source.
+type jsonValue struct {
+	kind    byte              // '{' or '[', 0 for scalars
+	raw     json.RawMessage   // the text of a scalar
+	keys    []string          // the keys of the members of an object
+	rawKeys []json.RawMessage // the text of the keys of the members of an object
+	values  []*jsonValue      // the values of the members of an object, or the elements of an array
+}
+
+// parseValue parses the valid JSON-encoded src in a single pass over its tokens.
+func parseValue(src []byte) (*jsonValue, error) {
+	dec := json.NewDecoder(bytes.NewReader(src))
+	// scalars are kept as text, so that numbers are not parsed as float64, failing out of its range
+	dec.UseNumber()
+	return decodeValue(dec, src)
+}
+
+func decodeValue(dec *json.Decoder, src []byte) (*jsonValue, error) {
+	start := dec.InputOffset()
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return &jsonValue{raw: tokenText(src, start, dec.InputOffset())}, nil
+	}
+	v := &jsonValue{kind: byte(delim)}
+	for dec.More() {
+		if v.kind == '{' {
+			start := dec.InputOffset()
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			v.keys = append(v.keys, key.(string))
+			v.rawKeys = append(v.rawKeys, tokenText(src, start, dec.InputOffset()))
+		}
+		e, err := decodeValue(dec, src)
+		if err != nil {
+			return nil, err
+		}
+		v.values = append(v.values, e)
+	}
+	// the closing delimiter
+	if _, err := dec.Token(); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// tokenText returns the text of the scalar or key token read from src between the input offsets start and end,
+// dropping the space and the separator before it, read with the token.
+func tokenText(src []byte, start, end int64) json.RawMessage {
+	return bytes.TrimLeft(src[start:end], " \t\r\n,:")
+}
+
+// diffEntry is a member or an element of two objects or arrays aligned, a or b is nil if missing.
+type diffEntry struct {
+	key  json.RawMessage // the text of the key of a member, nil for an element
+	a, b *jsonValue
+}
+
+type diffPrinter struct {
+	dst            []byte
+	prefix, indent string
+	started        bool
+}
+
+// newline begins a line marked with mark, indented by depth.
+func (p *diffPrinter) newline(mark byte, depth int) {
+	if p.started {
+		p.dst = append(p.dst, '\n')
+	}
+	p.dst = append(p.dst, mark, ' ')
+	if p.started {
+		p.dst = append(p.dst, p.prefix...)
+		for i := 0; i < depth; i++ {
+			p.dst = append(p.dst, p.indent...)
+		}
+	}
+	p.started = true
+}
+
+// label appends the key of a member, if any, as it is in the source.
+func (p *diffPrinter) label(key json.RawMessage) {
+	if key != nil {
+		p.dst = append(p.dst, key...)
+		p.dst = append(p.dst, ':', ' ')
+	}
+}
+
+// close appends the closing of an object or array, and a comma if needed.
+func (p *diffPrinter) close(kind byte, comma bool) {
+	if kind == '{' {
+		p.dst = append(p.dst, '}')
+	} else {
+		p.dst = append(p.dst, ']')
+	}
+	if comma {
+		p.dst = append(p.dst, ',')
+	}
+}
+
+// value prints v with all its lines marked with mark.
+func (p *diffPrinter) value(mark byte, depth int, key json.RawMessage, v *jsonValue, comma bool) {
+	p.newline(mark, depth)
+	p.label(key)
+	if v.kind == 0 {
+		p.dst = append(p.dst, v.raw...)
+		if comma {
+			p.dst = append(p.dst, ',')
+		}
+		return
+	}
+	p.dst = append(p.dst, v.kind)
+	if len(v.values) == 0 {
+		p.close(v.kind, comma)
+		return
+	}
+	for i, e := range v.values {
+		var k json.RawMessage
+		if v.kind == '{' {
+			k = v.rawKeys[i]
+		}
+		p.value(mark, depth+1, k, e, i < len(v.values)-1)
+	}
+	p.newline(mark, depth)
+	p.close(v.kind, comma)
+}
+
+// diff prints b, marked by how it differs from a.
+func (p *diffPrinter) diff(depth int, key json.RawMessage, a, b *jsonValue, comma bool) {
+	if a.kind != b.kind || (b.kind == 0 && !bytes.Equal(a.raw, b.raw)) {
+		p.value(DiffMarkChanged, depth, key, b, comma)
+		return
+	}
+	if b.kind == 0 {
+		p.value(DiffMarkSame, depth, key, b, comma)
+		return
+	}
+
+	var entries []diffEntry
+	if b.kind == '{' {
+		matched := make([]bool, len(a.keys))
+		for i := range b.keys {
+			e := diffEntry{key: b.rawKeys[i], b: b.values[i]}
+			for j, k := range a.keys {
+				if !matched[j] && k == b.keys[i] {
+					matched[j] = true
+					e.a = a.values[j]
+					break
+				}
+			}
+			entries = append(entries, e)
+		}
+		for j := range a.keys {
+			if !matched[j] {
+				entries = append(entries, diffEntry{key: a.rawKeys[j], a: a.values[j]})
+			}
+		}
+	} else {
+		for i := 0; i < max(len(a.values), len(b.values)); i++ {
+			var e diffEntry
+			if i < len(a.values) {
+				e.a = a.values[i]
+			}
+			if i < len(b.values) {
+				e.b = b.values[i]
+			}
+			entries = append(entries, e)
+		}
+	}
+
+	p.newline(DiffMarkSame, depth)
+	p.label(key)
+	p.dst = append(p.dst, b.kind)
+	if len(entries) == 0 {
+		p.close(b.kind, comma)
+		return
+	}
+	for i, e := range entries {
+		comma := i < len(entries)-1
+		switch {
+		case e.a == nil:
+			p.value(DiffMarkAdded, depth+1, e.key, e.b, comma)
+		case e.b == nil:
+			p.value(DiffMarkRemoved, depth+1, e.key, e.a, comma)
+		default:
+			p.diff(depth+1, e.key, e.a, e.b, comma)
+		}
+	}
+	p.newline(DiffMarkSame, depth)
+	p.close(b.kind, comma)
+}
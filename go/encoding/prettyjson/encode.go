@@ -58,6 +58,22 @@ func MarshalIndent(v any, prefix, indent string, opts ...EncOptsOption) ([]byte,
 	return buf.Bytes(), nil
 }
 
+// MarshalIndentSorted is like MarshalIndent but sorts the members of every object by their keys,
+// as IndentSorted does, so that the output is canonical, such as to diff or sign:
+// the fields of structs and the members written by Marshaler are sorted too, not only the keys of maps.
+func MarshalIndentSorted(v any, prefix, indent string, opts ...EncOptsOption) ([]byte, error) {
+	b, err := Marshal(v, opts...)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	err = IndentSorted(&buf, b, prefix, indent)
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // Marshaler is the interface implemented by types that
 // can marshal themselves into valid JSON.
 type Marshaler = json.Marshaler
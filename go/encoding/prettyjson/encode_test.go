@@ -1219,3 +1219,63 @@ func TestIssue63379(t *testing.T) {
 		}
 	}
 }
+
+func TestMarshalIndentSorted(t *testing.T) {
+	type inner struct {
+		Zeta  int    `json:"zeta"`
+		Alpha string `json:"alpha"`
+	}
+	type outer struct {
+		Name  string            `json:"name"`
+		Items []inner           `json:"items"`
+		Raw   RawMessage        `json:"raw"`
+		Attrs map[string]string `json:"attrs"`
+		Count float64           `json:"count"`
+	}
+	v := outer{
+		Name:  "a<b",
+		Items: []inner{{Zeta: 2, Alpha: "x"}, {Zeta: 1}},
+		Raw:   RawMessage(`{"y":1.0,"x":[{"b":true,"a":null}]}`),
+		Attrs: map[string]string{"k2": "v2", "k1": "v1", "k<": "v<"},
+		Count: 0.5,
+	}
+	got, err := MarshalIndentSorted(v, "", "  ")
+	if err != nil {
+		t.Fatalf("MarshalIndentSorted: %v", err)
+	}
+	want := `{
+  "attrs": {
+    "k1": "v1",
+    "k2": "v2",
+    "k\u003c": "v\u003c"
+  },
+  "count": 0.5,
+  "items": [
+    {
+      "alpha": "x",
+      "zeta": 2
+    },
+    {
+      "alpha": "",
+      "zeta": 1
+    }
+  ],
+  "name": "a\u003cb",
+  "raw": {
+    "x": [
+      {
+        "a": null,
+        "b": true
+      }
+    ],
+    "y": 1.0
+  }
+}`
+	if string(got) != want {
+		t.Errorf("MarshalIndentSorted:\n\tgot:  %s\n\twant: %s", got, want)
+	}
+
+	if _, err := MarshalIndentSorted(math.NaN(), "", "  "); err == nil {
+		t.Errorf("MarshalIndentSorted(NaN): got no error")
+	}
+}
@@ -7,6 +7,11 @@ package prettyjson
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf8"
 )
 
 func appendHTMLEscape(dst, src []byte) []byte {
@@ -80,8 +85,113 @@ func appendCompact(dst, src []byte, escape bool) ([]byte, error) {
 	return dst, nil
 }
 
-func appendNewline(dst []byte, prefix, indent string, depth int) []byte {
-	dst = append(dst, '\n')
+// NewCompactWriter returns a writer compacting the JSON-encoded value written to it
+// with insignificant space characters elided, as Compact does, and writing the result to w.
+// It compacts incrementally as bytes arrive, so that the input is never buffered as a whole,
+// and writes what it has compacted to w at the end of each Write.
+// If escapeHTML is true, <, >, & and U+2028, U+2029 are escaped as Encoder.SetEscapeHTML does.
+//
+// Close reports an error if the input ends in the middle of the value, or has no value at all;
+// it does not close w.
+func NewCompactWriter(w io.Writer, escapeHTML bool) io.WriteCloser {
+	return &compactWriter{w: w, escape: escapeHTML, scan: newScanner()}
+}
+
+var errCompactWriterClosed = errors.New("prettyjson: write to closed compact writer")
+
+type compactWriter struct {
+	w      io.Writer
+	escape bool
+	scan   *scanner
+	err    error
+
+	buf []byte
+	// U+2028 or U+2029 (E2 80 A8 or E2 80 A9) may be split across writes,
+	// so their leading bytes are held back until it is known which rune they start.
+	pending []byte
+}
+
+func (cw *compactWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	cw.buf = cw.buf[:0]
+	n := 0
+	for _, c := range p {
+		cw.scan.bytes++
+		v := cw.scan.step(cw.scan, c)
+		if v == scanError {
+			cw.err = cw.scan.err
+			break
+		}
+		n++
+		if v >= scanSkipSpace {
+			continue
+		}
+		cw.appendByte(c)
+	}
+	if _, err := cw.w.Write(cw.buf); err != nil && cw.err == nil {
+		cw.err = err
+	}
+	return n, cw.err
+}
+
+func (cw *compactWriter) appendByte(c byte) {
+	if !cw.escape {
+		cw.buf = append(cw.buf, c)
+		return
+	}
+	switch {
+	case len(cw.pending) == 1 && c == 0x80:
+		cw.pending = append(cw.pending, c)
+		return
+	case len(cw.pending) == 2 && c&^1 == 0xA8:
+		// Convert U+2028 and U+2029 (E2 80 A8 and E2 80 A9).
+		cw.buf = append(cw.buf, '\\', 'u', '2', '0', '2', hex[c&0xF])
+		cw.pending = cw.pending[:0]
+		return
+	}
+	cw.buf = append(cw.buf, cw.pending...)
+	cw.pending = cw.pending[:0]
+	switch c {
+	case 0xE2:
+		cw.pending = append(cw.pending, c)
+	case '<', '>', '&':
+		cw.buf = append(cw.buf, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+	default:
+		cw.buf = append(cw.buf, c)
+	}
+}
+
+// Close writes out bytes held back, and reports an error if the value written is incomplete.
+func (cw *compactWriter) Close() error {
+	if cw.scan == nil {
+		return cw.err
+	}
+	defer func() {
+		freeScanner(cw.scan)
+		cw.scan = nil
+		if cw.err == nil {
+			cw.err = errCompactWriterClosed
+		}
+	}()
+	if cw.err != nil {
+		return cw.err
+	}
+	if len(cw.pending) > 0 {
+		if _, err := cw.w.Write(cw.pending); err != nil {
+			return err
+		}
+		cw.pending = nil
+	}
+	if cw.scan.eof() == scanError {
+		return cw.scan.err
+	}
+	return nil
+}
+
+func appendNewline(dst []byte, lineEnding, prefix, indent string, depth int) []byte {
+	dst = append(dst, lineEnding...)
 	dst = append(dst, prefix...)
 	for i := 0; i < depth; i++ {
 		dst = append(dst, indent...)
@@ -104,25 +214,114 @@ func Indent(dst *bytes.Buffer, src []byte, prefix, indent string) error {
 	return json.Indent(dst, src, prefix, indent)
 }
 
-func appendIndent(dst, src []byte, prefix, indent string) ([]byte, error) {
+// IndentWithLineEnding appends to dst an indented form of the JSON-encoded src, as Indent does,
+// but ends lines with lineEnding, either "\n" or "\r\n", such as to match the settings of editors on Windows.
+// Trailing space characters at the end of src are dropped.
+func IndentWithLineEnding(dst *bytes.Buffer, src []byte, prefix, indent, lineEnding string) error {
+	if lineEnding != "\n" && lineEnding != "\r\n" {
+		return fmt.Errorf("prettyjson: invalid line ending %q", lineEnding)
+	}
+	dst.Grow(indentGrowthFactor * len(src))
+	b := dst.AvailableBuffer()
+	b, err := appendIndent(b, src, prefix, indent, lineEnding)
+	dst.Write(b)
+	return err
+}
+
+// IndentLimit appends to dst an indented form of the JSON-encoded src, as Indent does,
+// but fails with a *SyntaxError once objects and arrays are nested deeper than maxDepth,
+// such as to bound the work of pretty-printing untrusted payloads.
+// A maxDepth of 0 allows scalar values only, 1 an object or array of scalar values, and so on.
+// Nothing is appended to dst on error.
+func IndentLimit(dst *bytes.Buffer, src []byte, prefix, indent string, maxDepth int) error {
+	if maxDepth < 0 {
+		return fmt.Errorf("prettyjson: invalid max depth %d", maxDepth)
+	}
+	dst.Grow(indentGrowthFactor * len(src))
+	b := dst.AvailableBuffer()
+	ind := newIndenter(prefix, indent)
+	ind.maxDepth = maxDepth
+	b, err := ind.appendIndent(b, src)
+	dst.Write(b)
+	return err
+}
+
+func appendIndent(dst, src []byte, prefix, indent, lineEnding string) ([]byte, error) {
+	ind := newIndenter(prefix, indent)
+	ind.lineEnding = lineEnding
+	return ind.appendIndent(dst, src)
+}
+
+// indenter holds the options of the forms of Indent, each of them formatting as Indent does if unset.
+type indenter struct {
+	prefix, indent string
+	lineEnding     string
+
+	// maxDepth fails once objects and arrays are nested deeper, unless negative.
+	maxDepth int
+	// theme colors the tokens, as IndentColored does.
+	theme ColorTheme
+	// inlineWidths are the widths of the objects and arrays, in the order they begin, as inlineWidths returns,
+	// keeping those fitting in maxInlineWidth on one line, as IndentInline does.
+	inlineWidths   []int
+	maxInlineWidth int
+	// keyWidths are the widths of the longest keys of the objects, in the order they begin, as objectKeyWidths returns,
+	// aligning the values of each object in a column, as IndentAligned does.
+	keyWidths []int
+}
+
+// newIndenter returns an indenter formatting as Indent does, but dropping trailing space characters.
+func newIndenter(prefix, indent string) indenter {
+	return indenter{prefix: prefix, indent: indent, lineEnding: "\n", maxDepth: -1}
+}
+
+// appendIndent appends to dst the indented form of src, or nothing on error.
+func (ind indenter) appendIndent(dst, src []byte) ([]byte, error) {
 	origLen := len(dst)
 	scan := newScanner()
 	defer freeScanner(scan)
 	needIndent := false
 	depth := 0
+	colored := false  // whether the literal scanned is colored, to be reset at its end
+	next := 0         // index into inlineWidths of the next object or array
+	inline := -1      // nesting depth of the object or array kept on one line, -1 if none
+	var objects []int // indexes into keyWidths of the objects nested, -1 for arrays
+	nextObject := 0   // index into keyWidths of the next object
+	keyWidth := -1    // width of the key scanned, -1 if not in a key
 	for _, c := range src {
 		scan.bytes++
 		v := scan.step(scan, c)
+		if colored && v != scanContinue {
+			colored = false
+			dst = append(dst, colorReset...)
+		}
 		if v == scanSkipSpace {
 			continue
 		}
 		if v == scanError {
 			break
 		}
+		if ind.maxDepth >= 0 && (v == scanBeginObject || v == scanBeginArray) && len(scan.parseState) > ind.maxDepth {
+			return dst[:origLen], &SyntaxError{"exceeded max depth " + strconv.Itoa(ind.maxDepth), scan.bytes}
+		}
 		if needIndent && v != scanEndObject && v != scanEndArray {
 			needIndent = false
 			depth++
-			dst = appendNewline(dst, prefix, indent, depth)
+			dst = appendNewline(dst, ind.lineEnding, ind.prefix, ind.indent, depth)
+		}
+		if v == scanBeginLiteral {
+			n := len(scan.parseState)
+			key := n > 0 && scan.parseState[n-1] == parseObjectKey
+			if color := ind.theme.color(c, key); color != "" {
+				colored = true
+				dst = append(dst, color...)
+			}
+			if key && ind.keyWidths != nil {
+				keyWidth = 0
+			}
+		}
+		if keyWidth >= 0 && utf8.RuneStart(c) && v != scanObjectKey {
+			keyWidth++
 		}
 
 		// Emit semantically uninteresting bytes
@@ -135,21 +334,54 @@ func appendIndent(dst, src []byte, prefix, indent string) ([]byte, error) {
 		// Add spacing around real punctuation.
 		switch c {
 		case '{', '[':
-			// delay indent so that empty object and array are formatted as {} and [].
-			needIndent = true
+			if ind.keyWidths != nil {
+				if c == '{' {
+					objects = append(objects, nextObject)
+					nextObject++
+				} else {
+					objects = append(objects, -1)
+				}
+			}
+			if ind.inlineWidths != nil {
+				if inline < 0 && ind.inlineWidths[next] <= ind.maxInlineWidth {
+					inline = len(scan.parseState)
+				}
+				next++
+			}
+			if inline < 0 {
+				// delay indent so that empty object and array are formatted as {} and [].
+				needIndent = true
+			}
 			dst = append(dst, c)
 		case ',':
+			if inline >= 0 {
+				dst = append(dst, c, ' ')
+				break
+			}
 			dst = append(dst, c)
-			dst = appendNewline(dst, prefix, indent, depth)
+			dst = appendNewline(dst, ind.lineEnding, ind.prefix, ind.indent, depth)
 		case ':':
 			dst = append(dst, c, ' ')
+			if keyWidth >= 0 {
+				for i := keyWidth; i < ind.keyWidths[objects[len(objects)-1]]; i++ {
+					dst = append(dst, ' ')
+				}
+				keyWidth = -1
+			}
 		case '}', ']':
-			if needIndent {
+			if ind.keyWidths != nil {
+				objects = objects[:len(objects)-1]
+			}
+			if inline >= 0 {
+				if len(scan.parseState) < inline {
+					inline = -1
+				}
+			} else if needIndent {
 				// suppress indent in empty object/array
 				needIndent = false
 			} else {
 				depth--
-				dst = appendNewline(dst, prefix, indent, depth)
+				dst = appendNewline(dst, ind.lineEnding, ind.prefix, ind.indent, depth)
 			}
 			dst = append(dst, c)
 		default:
@@ -159,5 +391,166 @@ func appendIndent(dst, src []byte, prefix, indent string) ([]byte, error) {
 	if scan.eof() == scanError {
 		return dst[:origLen], scan.err
 	}
+	if colored {
+		dst = append(dst, colorReset...)
+	}
 	return dst, nil
 }
+
+// DefaultMaxInlineWidth is a width of lines in bytes to pass to IndentInline, that of a terminal by default.
+const DefaultMaxInlineWidth = 80
+
+// IndentInline appends to dst an indented form of the JSON-encoded src, as Indent does,
+// but keeps each object or array on one line if it fits in maxInlineWidth bytes, such as [1, 2, 3],
+// with a space after each comma and colon, so that only the larger ones begin each element on a new line.
+// The width is that of the object or array alone, not counting the indentation and key before it.
+// A maxInlineWidth of DefaultMaxInlineWidth suits terminals, one less than 2 keeps nothing on one line,
+// formatting as Indent does.
+func IndentInline(dst *bytes.Buffer, src []byte, prefix, indent string, maxInlineWidth int) error {
+	widths, err := inlineWidths(src)
+	if err != nil {
+		return err
+	}
+	ind := newIndenter(prefix, indent)
+	ind.inlineWidths, ind.maxInlineWidth = widths, maxInlineWidth
+	dst.Grow(indentGrowthFactor * len(src))
+	b := dst.AvailableBuffer()
+	b, err = ind.appendIndent(b, src)
+	dst.Write(b)
+	return err
+}
+
+// inlineWidths returns the widths of the objects and arrays in src kept on one line,
+// with a space after each comma and colon, in the order they begin.
+func inlineWidths(src []byte) ([]int, error) {
+	scan := newScanner()
+	defer freeScanner(scan)
+	var widths []int
+	var open []int // indexes into widths of the objects and arrays nested
+	n := 0         // the width of the line up to the byte scanned
+	for _, c := range src {
+		scan.bytes++
+		v := scan.step(scan, c)
+		switch v {
+		case scanError:
+			return nil, scan.err
+		case scanSkipSpace:
+			continue
+		case scanBeginObject, scanBeginArray:
+			open = append(open, len(widths))
+			widths = append(widths, n)
+		case scanEndObject, scanEndArray:
+			i := open[len(open)-1]
+			open = open[:len(open)-1]
+			widths[i] = n + 1 - widths[i]
+		case scanObjectKey, scanObjectValue, scanArrayValue:
+			n++ // the space after the colon or comma
+		}
+		n++
+	}
+	if scan.eof() == scanError {
+		return nil, scan.err
+	}
+	return widths, nil
+}
+
+// IndentSanitizeUTF8 appends to dst an indented form of the JSON-encoded src, as Indent does,
+// with every byte of an invalid UTF-8 sequence in strings replaced by U+FFFD, the replacement character,
+// as encoding/json does when marshaling strings, so that the output is valid UTF-8 whatever the sources of src.
+// Such bytes can only appear in strings, as any other byte outside of ASCII is a syntax error.
+// Trailing space characters at the end of src are dropped.
+func IndentSanitizeUTF8(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	b, err := appendIndent(nil, src, prefix, indent, "\n")
+	if err != nil {
+		return err
+	}
+	dst.Grow(len(b))
+	dst.Write(appendValidUTF8(dst.AvailableBuffer(), b))
+	return nil
+}
+
+// appendValidUTF8 appends src to dst, with every byte of an invalid UTF-8 sequence replaced by U+FFFD.
+func appendValidUTF8(dst, src []byte) []byte {
+	if utf8.Valid(src) {
+		return append(dst, src...)
+	}
+	start := 0
+	for i := 0; i < len(src); {
+		if src[i] < utf8.RuneSelf {
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(src[i:])
+		if r == utf8.RuneError && size == 1 {
+			dst = append(dst, src[start:i]...)
+			dst = utf8.AppendRune(dst, utf8.RuneError)
+			start = i + size
+		}
+		i += size
+	}
+	return append(dst, src[start:]...)
+}
+
+// IndentAligned appends to dst an indented form of the JSON-encoded src, as Indent does,
+// with the values of each object aligned in a column:
+// the spaces after the colon of a key are padded so that all values of the object start at the same column.
+// Keys are measured in runes, and nested objects align their own values independently.
+// The output is meant to be displayed, though it stays valid JSON.
+func IndentAligned(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	widths, err := objectKeyWidths(src)
+	if err != nil {
+		return err
+	}
+	ind := newIndenter(prefix, indent)
+	ind.keyWidths = widths
+	dst.Grow(indentGrowthFactor * len(src))
+	b := dst.AvailableBuffer()
+	b, err = ind.appendIndent(b, src)
+	dst.Write(b)
+	return err
+}
+
+// indentGrowthFactor is a guess of how much larger the indented form of JSON is.
+const indentGrowthFactor = 2
+
+// objectKeyWidths returns the widths of the longest keys of the objects in src, in the order the objects begin.
+func objectKeyWidths(src []byte) ([]int, error) {
+	scan := newScanner()
+	defer freeScanner(scan)
+	var widths []int
+	var objects []int // indexes into widths of the objects nested, -1 for arrays
+	keyWidth := -1    // width of the key scanned, -1 if not in a key
+	for _, c := range src {
+		scan.bytes++
+		v := scan.step(scan, c)
+		switch v {
+		case scanError:
+			return nil, scan.err
+		case scanSkipSpace:
+			continue
+		case scanBeginObject:
+			objects = append(objects, len(widths))
+			widths = append(widths, 0)
+		case scanBeginArray:
+			objects = append(objects, -1)
+		case scanEndObject, scanEndArray:
+			objects = objects[:len(objects)-1]
+		case scanBeginLiteral:
+			if n := len(scan.parseState); n > 0 && scan.parseState[n-1] == parseObjectKey {
+				keyWidth = 0
+			}
+		case scanObjectKey:
+			if i := objects[len(objects)-1]; keyWidth > widths[i] {
+				widths[i] = keyWidth
+			}
+			keyWidth = -1
+		}
+		if keyWidth >= 0 && utf8.RuneStart(c) {
+			keyWidth++
+		}
+	}
+	if scan.eof() == scanError {
+		return nil, scan.err
+	}
+	return widths, nil
+}
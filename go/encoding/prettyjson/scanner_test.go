@@ -6,10 +6,14 @@ package prettyjson
 
 import (
 	"bytes"
+	"errors"
+	"io"
 	"math"
 	"math/rand"
+	"strconv"
 	"strings"
 	"testing"
+	"unicode/utf8"
 )
 
 func indentNewlines(s string) string {
@@ -116,6 +120,98 @@ func TestCompactSeparators(t *testing.T) {
 	}
 }
 
+// writeChunks writes src to w in chunks of n bytes at most.
+func writeChunks(w io.Writer, src []byte, n int) error {
+	for len(src) > 0 {
+		chunk := src[:min(n, len(src))]
+		if _, err := w.Write(chunk); err != nil {
+			return err
+		}
+		src = src[len(chunk):]
+	}
+	return nil
+}
+
+func TestCompactWriter(t *testing.T) {
+	var buf bytes.Buffer
+	for _, n := range []int{1, 2, 3, 7, 1 << 20} {
+		for _, tt := range examples {
+			buf.Reset()
+			w := NewCompactWriter(&buf, false)
+			if err := writeChunks(w, []byte(tt.indent), n); err != nil {
+				t.Errorf("CompactWriter(%#q, %d): %v", tt.indent, n, err)
+				continue
+			}
+			if err := w.Close(); err != nil {
+				t.Errorf("CompactWriter(%#q, %d): Close: %v", tt.indent, n, err)
+			} else if s := buf.String(); s != tt.compact {
+				t.Errorf("CompactWriter(%#q, %d) = %#q, want %#q", tt.indent, n, s, tt.compact)
+			}
+		}
+	}
+}
+
+func TestCompactWriterEscapeHTML(t *testing.T) {
+	tests := []string{
+		"{\"<a href=\\\"x\\\">&</a>\": \"\u2028\u2029\"}",
+		"[\"\u00e2\u2028\u20ac\", \"\u2029\u00e2\"]",
+		"\"\u2029\"",
+	}
+	for _, src := range tests {
+		want, err := appendCompact(nil, []byte(src), true)
+		if err != nil {
+			t.Fatalf("appendCompact(%q): %v", src, err)
+		}
+		for _, n := range []int{1, 2, 3, len(src)} {
+			var buf bytes.Buffer
+			w := NewCompactWriter(&buf, true)
+			if err := writeChunks(w, []byte(src), n); err != nil {
+				t.Errorf("CompactWriter(%q, %d): %v", src, n, err)
+				continue
+			}
+			if err := w.Close(); err != nil {
+				t.Errorf("CompactWriter(%q, %d): Close: %v", src, n, err)
+			} else if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("CompactWriter(%q, %d) = %q, want %q", src, n, buf.Bytes(), want)
+			}
+		}
+	}
+}
+
+func TestCompactWriterError(t *testing.T) {
+	tests := []struct {
+		in       string
+		writeErr bool // error reported by Write, else by Close
+	}{
+		{"", false},
+		{" ", false},
+		{`{"a":`, false},
+		{`[1, 2`, false},
+		{`"abc`, false},
+		{`{]`, true},
+		{`{} {}`, true},
+	}
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		w := NewCompactWriter(&buf, false)
+		_, werr := w.Write([]byte(tt.in))
+		cerr := w.Close()
+		if tt.writeErr && werr == nil {
+			t.Errorf("CompactWriter(%#q): Write: got nil error, want error", tt.in)
+		}
+		if !tt.writeErr && werr != nil {
+			t.Errorf("CompactWriter(%#q): Write: %v, want nil error", tt.in, werr)
+		}
+		var syntaxErr *SyntaxError
+		if !errors.As(cerr, &syntaxErr) {
+			t.Errorf("CompactWriter(%#q): Close: got %v, want SyntaxError", tt.in, cerr)
+		}
+		if _, err := w.Write([]byte("1")); err == nil {
+			t.Errorf("CompactWriter(%#q): Write after Close: got nil error, want error", tt.in)
+		}
+	}
+}
+
 func TestIndent(t *testing.T) {
 	var buf bytes.Buffer
 	for _, tt := range examples {
@@ -152,6 +248,26 @@ func TestCompactBig(t *testing.T) {
 	}
 }
 
+func TestCompactWriterBig(t *testing.T) {
+	initBig()
+	var indented bytes.Buffer
+	if err := Indent(&indented, jsonBig, "", "\t"); err != nil {
+		t.Fatalf("Indent: %v", err)
+	}
+	var buf bytes.Buffer
+	w := NewCompactWriter(&buf, false)
+	if err := writeChunks(w, indented.Bytes(), 61); err != nil {
+		t.Fatalf("CompactWriter: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("CompactWriter: Close: %v", err)
+	}
+	if b := buf.Bytes(); !bytes.Equal(b, jsonBig) {
+		t.Error("CompactWriter(Indent(jsonBig)) != jsonBig")
+		diff(t, b, jsonBig)
+	}
+}
+
 func TestIndentBig(t *testing.T) {
 	t.Parallel()
 	initBig()
@@ -289,3 +405,475 @@ func genMap(n int) map[string]any {
 	}
 	return x
 }
+
+func TestIndentAligned(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`1`, `1`},
+		{`{}`, `{}`},
+		{`[]`, `[]`},
+		{`{"a":1}`, "{\n\t\"a\": 1\n}"},
+		{`{"a":1,"bcd":2,"ef":{}}`, "{\n\t\"a\":   1,\n\t\"bcd\": 2,\n\t\"ef\":  {}\n}"},
+		{
+			`{"name":"x", "nested":{"k":[1,{"long key":true,"s":null}],"kk":"v"},"z" : "a:b,c"}`,
+			"{\n" +
+				"\t\"name\":   \"x\",\n" +
+				"\t\"nested\": {\n" +
+				"\t\t\"k\":  [\n" +
+				"\t\t\t1,\n" +
+				"\t\t\t{\n" +
+				"\t\t\t\t\"long key\": true,\n" +
+				"\t\t\t\t\"s\":        null\n" +
+				"\t\t\t}\n" +
+				"\t\t],\n" +
+				"\t\t\"kk\": \"v\"\n" +
+				"\t},\n" +
+				"\t\"z\":      \"a:b,c\"\n" +
+				"}",
+		},
+		// keys are measured in runes, not bytes
+		{`{"日本":1,"abc":2}`, "{\n\t\"日本\":  1,\n\t\"abc\": 2\n}"},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		if err := IndentAligned(&buf, []byte(tt.in), "", "\t"); err != nil {
+			t.Errorf("IndentAligned(%#q): %v", tt.in, err)
+			continue
+		}
+		if s := buf.String(); s != tt.want {
+			t.Errorf("IndentAligned(%#q) = %#q, want %#q", tt.in, s, tt.want)
+		}
+		if s := buf.String(); stripWhitespace(s) != stripWhitespace(tt.in) {
+			t.Errorf("IndentAligned(%#q) = %#q, changed the value", tt.in, s)
+		}
+	}
+
+	for _, in := range []string{`{"a":1`, `{"a" 1}`, `[1,]`} {
+		buf.Reset()
+		buf.WriteString("x")
+		var syntaxErr *SyntaxError
+		if err := IndentAligned(&buf, []byte(in), "", "\t"); !errors.As(err, &syntaxErr) {
+			t.Errorf("IndentAligned(%#q): got %v, want SyntaxError", in, err)
+		}
+		if buf.String() != "x" {
+			t.Errorf("IndentAligned(%#q): wrote %#q on error", in, buf.String())
+		}
+	}
+
+	// should get back to original
+	initBig()
+	buf.Reset()
+	if err := IndentAligned(&buf, jsonBig, "", "\t"); err != nil {
+		t.Fatalf("IndentAligned: %v", err)
+	}
+	var compact bytes.Buffer
+	if err := Compact(&compact, buf.Bytes()); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	if b := compact.Bytes(); !bytes.Equal(b, jsonBig) {
+		t.Error("Compact(IndentAligned(jsonBig)) != jsonBig")
+		diff(t, b, jsonBig)
+	}
+}
+
+func TestIndentWithLineEnding(t *testing.T) {
+	tests := []struct {
+		in, lf, crlf string
+	}{
+		{`1`, `1`, `1`},
+		{`{}`, `{}`, `{}`},
+		{`{"a":1}`, "{\n>\t\"a\": 1\n>}", "{\r\n>\t\"a\": 1\r\n>}"},
+		{
+			`{"a":[1,{"b":"c\nd"}],"e":[]}`,
+			"{\n>\t\"a\": [\n>\t\t1,\n>\t\t{\n>\t\t\t\"b\": \"c\\nd\"\n>\t\t}\n>\t],\n>\t\"e\": []\n>}",
+			"{\r\n>\t\"a\": [\r\n>\t\t1,\r\n>\t\t{\r\n>\t\t\t\"b\": \"c\\nd\"\r\n>\t\t}\r\n>\t],\r\n>\t\"e\": []\r\n>}",
+		},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		for _, le := range []struct{ lineEnding, want string }{{"\n", tt.lf}, {"\r\n", tt.crlf}} {
+			buf.Reset()
+			if err := IndentWithLineEnding(&buf, []byte(tt.in), ">", "\t", le.lineEnding); err != nil {
+				t.Errorf("IndentWithLineEnding(%#q, %q): %v", tt.in, le.lineEnding, err)
+				continue
+			}
+			if !bytes.Equal(buf.Bytes(), []byte(le.want)) {
+				t.Errorf("IndentWithLineEnding(%#q, %q) = %#q, want %#q", tt.in, le.lineEnding, buf.String(), le.want)
+			}
+		}
+
+		// LF output matches Indent
+		buf.Reset()
+		if err := Indent(&buf, []byte(tt.in), ">", "\t"); err != nil || buf.String() != tt.lf {
+			t.Errorf("Indent(%#q) = %#q, %v, want %#q", tt.in, buf.String(), err, tt.lf)
+		}
+	}
+
+	buf.Reset()
+	if err := IndentWithLineEnding(&buf, []byte(`{}`), "", "\t", "\r"); err == nil {
+		t.Errorf("IndentWithLineEnding with line ending %q: got no error", "\r")
+	}
+	var syntaxErr *SyntaxError
+	if err := IndentWithLineEnding(&buf, []byte(`{"a":1`), "", "\t", "\r\n"); !errors.As(err, &syntaxErr) || buf.Len() != 0 {
+		t.Errorf("IndentWithLineEnding(%#q): got %v, wrote %#q, want SyntaxError", `{"a":1`, err, buf.String())
+	}
+}
+
+func TestIndentSanitizeUTF8(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`"ok"`, `"ok"`},
+		{`{"a":"héllo"}`, "{\n\t\"a\": \"héllo\"\n}"},
+		{"{\"a\":\"b\xffc\"}", "{\n\t\"a\": \"b\uFFFDc\"\n}"},
+		// every byte of an invalid sequence is replaced, and valid runes around are kept
+		{"[\"\xe2\x82\", \"\xc0\xafé\"]", "[\n\t\"\uFFFD\uFFFD\",\n\t\"\uFFFD\uFFFDé\"\n]"},
+		// in keys too
+		{"{\"k\x80\":1}", "{\n\t\"k\uFFFD\": 1\n}"},
+		// a truncated rune at the end of a string
+		{"\"\xf0\x9f\x98\"", "\"\uFFFD\uFFFD\uFFFD\""},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		if err := IndentSanitizeUTF8(&buf, []byte(tt.in), "", "\t"); err != nil {
+			t.Errorf("IndentSanitizeUTF8(%q): %v", tt.in, err)
+			continue
+		}
+		if buf.String() != tt.want {
+			t.Errorf("IndentSanitizeUTF8(%q) = %q, want %q", tt.in, buf.String(), tt.want)
+		}
+		if !utf8.Valid(buf.Bytes()) || !Valid(buf.Bytes()) {
+			t.Errorf("IndentSanitizeUTF8(%q) = %q, want valid UTF-8 JSON", tt.in, buf.String())
+		}
+	}
+
+	buf.Reset()
+	var syntaxErr *SyntaxError
+	if err := IndentSanitizeUTF8(&buf, []byte("[\"\xff\""), "", "\t"); !errors.As(err, &syntaxErr) || buf.Len() != 0 {
+		t.Errorf("IndentSanitizeUTF8(%q): got %v, wrote %q, want SyntaxError", "[\"\xff\"", err, buf.String())
+	}
+}
+
+func TestIndentDiff(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{`1`, `1`, `  1`},
+		{`1`, `2`, `~ 2`},
+		{`1`, `1.0`, `~ 1.0`},
+		{`{}`, `[]`, `~ []`},
+		{`{}`, `{}`, `  {}`},
+		{
+			`{"same": "x", "changed": 1, "removed": {"k": [1]}, "nested": {"a": true, "b": null}}`,
+			`{"added": [1, {}], "same": "x", "changed": "1", "nested": {"b": null, "a": false}}`,
+			"  {\n" +
+				"+ >\t\"added\": [\n" +
+				"+ >\t\t1,\n" +
+				"+ >\t\t{}\n" +
+				"+ >\t],\n" +
+				"  >\t\"same\": \"x\",\n" +
+				"~ >\t\"changed\": \"1\",\n" +
+				"  >\t\"nested\": {\n" +
+				"  >\t\t\"b\": null,\n" +
+				"~ >\t\t\"a\": false\n" +
+				"  >\t},\n" +
+				"- >\t\"removed\": {\n" +
+				"- >\t\t\"k\": [\n" +
+				"- >\t\t\t1\n" +
+				"- >\t\t]\n" +
+				"- >\t}\n" +
+				"  >}",
+		},
+		{
+			`[1, 2, 3]`,
+			`[1, 5]`,
+			"  [\n" +
+				"  >\t1,\n" +
+				"~ >\t5,\n" +
+				"- >\t3\n" +
+				"  >]",
+		},
+		{
+			`[1]`,
+			`[1, "a<b"]`,
+			"  [\n" +
+				"  >\t1,\n" +
+				"+ >\t\"a<b\"\n" +
+				"  >]",
+		},
+		// keys are matched decoded, and shown as they are in b, or in a if removed
+		{
+			`{"\u00e9": 1, "r\u00e9": 0}`,
+			`{"é": 2, "\u0062": "\u00e9"}`,
+			"  {\n" +
+				"~ >\t\"é\": 2,\n" +
+				"+ >\t\"\\u0062\": \"\\u00e9\",\n" +
+				"- >\t\"r\\u00e9\": 0\n" +
+				"  >}",
+		},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		if err := IndentDiff(&buf, []byte(tt.a), []byte(tt.b), ">", "\t"); err != nil {
+			t.Errorf("IndentDiff(%#q, %#q): %v", tt.a, tt.b, err)
+			continue
+		}
+		if got := buf.String(); got != tt.want {
+			t.Errorf("IndentDiff(%#q, %#q) =\n%s\nwant:\n%s", tt.a, tt.b, got, tt.want)
+		}
+	}
+
+	var syntaxErr *SyntaxError
+	for _, in := range [][2]string{{`{"a":1`, `{}`}, {`{}`, `[1,]`}} {
+		buf.Reset()
+		if err := IndentDiff(&buf, []byte(in[0]), []byte(in[1]), "", "\t"); !errors.As(err, &syntaxErr) || buf.Len() != 0 {
+			t.Errorf("IndentDiff(%#q, %#q): got %v, wrote %#q, want SyntaxError", in[0], in[1], err, buf.String())
+		}
+	}
+}
+
+func TestIndentSorted(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{`1`, `1`},
+		{`"b"`, `"b"`},
+		{`{}`, `{}`},
+		{`{"b":1,"a":2}`, "{\n\t\"a\": 2,\n\t\"b\": 1\n}"},
+		// nested objects, and objects in arrays
+		{
+			`{"z":{"y":[{"d":1,"c":[]}],"x":{}},"a":[3,1,2]}`,
+			"{\n\t\"a\": [\n\t\t3,\n\t\t1,\n\t\t2\n\t],\n\t\"z\": {\n\t\t\"x\": {},\n\t\t\"y\": [\n\t\t\t{\n\t\t\t\t\"c\": [],\n\t\t\t\t\"d\": 1\n\t\t\t}\n\t\t]\n\t}\n}",
+		},
+		// keys and scalar values are left as they are, keys are compared byte-wise once decoded
+		{`{"b":1.50,"B":"\u00e9<","a\u00e9":1e3,"a":-0}`, "{\n\t\"B\": \"\\u00e9<\",\n\t\"a\": -0,\n\t\"a\\u00e9\": 1e3,\n\t\"b\": 1.50\n}"},
+		{`{"\u0063":[1e400],"b\u003c":1,"a":2}`, "{\n\t\"a\": 2,\n\t\"b\\u003c\": 1,\n\t\"\\u0063\": [\n\t\t1e400\n\t]\n}"},
+		// members sharing a key keep their order
+		{` { "k" : 2 , "a" : null , "k" : 1 } `, "{\n\t\"a\": null,\n\t\"k\": 2,\n\t\"k\": 1\n}"},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		if err := IndentSorted(&buf, []byte(tt.in), "", "\t"); err != nil {
+			t.Errorf("IndentSorted(%#q): %v", tt.in, err)
+			continue
+		}
+		if buf.String() != tt.want {
+			t.Errorf("IndentSorted(%#q) = %q, want %q", tt.in, buf.String(), tt.want)
+		}
+	}
+
+	var syntaxErr *SyntaxError
+	for _, in := range []string{`{"b":1,"a":}`, `[1,]`, ``} {
+		buf.Reset()
+		if err := IndentSorted(&buf, []byte(in), "", "\t"); !errors.As(err, &syntaxErr) || buf.Len() != 0 {
+			t.Errorf("IndentSorted(%#q): got %v, wrote %#q, want SyntaxError", in, err, buf.String())
+		}
+	}
+}
+
+func TestIndentColored(t *testing.T) {
+	const reset = "\x1b[0m"
+	theme := ColorTheme{Key: "<k>", String: "<s>", Number: "<n>", Bool: "<b>", Null: "<0>"}
+	tests := []struct {
+		in, want string
+	}{
+		{`1`, "<n>1" + reset},
+		{` "a\"b"`, `<s>"a\"b"` + reset},
+		{`{}`, `{}`},
+		{
+			`{"s":"x","n":-1.5e3,"t":true,"f":false,"z":null,"o":{"k":[]}}`,
+			"{\n" +
+				"\t<k>\"s\"" + reset + ": <s>\"x\"" + reset + ",\n" +
+				"\t<k>\"n\"" + reset + ": <n>-1.5e3" + reset + ",\n" +
+				"\t<k>\"t\"" + reset + ": <b>true" + reset + ",\n" +
+				"\t<k>\"f\"" + reset + ": <b>false" + reset + ",\n" +
+				"\t<k>\"z\"" + reset + ": <0>null" + reset + ",\n" +
+				"\t<k>\"o\"" + reset + ": {\n" +
+				"\t\t<k>\"k\"" + reset + ": []\n" +
+				"\t}\n" +
+				"}",
+		},
+		// the end of a literal is found at the space or punctuation after it
+		{`[ 1 , "k" ]`, "[\n\t<n>1" + reset + ",\n\t<s>\"k\"" + reset + "\n]"},
+		{`{"a":{"b":2}}`, "{\n\t<k>\"a\"" + reset + ": {\n\t\t<k>\"b\"" + reset + ": <n>2" + reset + "\n\t}\n}"},
+		// tokens with no escape sequence are not colored
+		{`{"a":[1,null]}`, "{\n\t<k>\"a\"" + reset + ": [\n\t\t<n>1" + reset + ",\n\t\t<0>null" + reset + "\n\t]\n}"},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		if err := IndentColored(&buf, []byte(tt.in), "", "\t", theme); err != nil {
+			t.Errorf("IndentColored(%#q): %v", tt.in, err)
+			continue
+		}
+		if buf.String() != tt.want {
+			t.Errorf("IndentColored(%#q) = %q, want %q", tt.in, buf.String(), tt.want)
+		}
+	}
+
+	buf.Reset()
+	if err := IndentColored(&buf, []byte(`{"a":1}`), "", "\t", ColorTheme{Number: "<n>"}); err != nil || buf.String() != "{\n\t\"a\": <n>1"+reset+"\n}" {
+		t.Errorf("IndentColored with keys not colored = %q, %v", buf.String(), err)
+	}
+
+	// NoColorTheme formats as Indent does
+	for _, tt := range examples {
+		buf.Reset()
+		if err := IndentColored(&buf, []byte(tt.compact), "", "\t", NoColorTheme); err != nil {
+			t.Errorf("IndentColored(%#q): %v", tt.compact, err)
+			continue
+		}
+		if buf.String() != tt.indent {
+			t.Errorf("IndentColored(%#q) = %#q, want %#q", tt.compact, buf.String(), tt.indent)
+		}
+	}
+
+	var syntaxErr *SyntaxError
+	for _, in := range []string{`{"a":1`, `[1,]`, `"a`} {
+		buf.Reset()
+		if err := IndentColored(&buf, []byte(in), "", "\t", DefaultColorTheme); !errors.As(err, &syntaxErr) || buf.Len() != 0 {
+			t.Errorf("IndentColored(%#q): got %v, wrote %q, want SyntaxError", in, err, buf.String())
+		}
+	}
+}
+
+func TestIndentLimit(t *testing.T) {
+	tests := []struct {
+		in       string
+		maxDepth int
+		ok       bool
+	}{
+		{`1`, 0, true},
+		{`"[[["`, 0, true},
+		{`[]`, 0, false},
+		{`{}`, 0, false},
+		{`[]`, 1, true},
+		{`[1,{"a":[]}]`, 2, false},
+		{`[1,{"a":[]}]`, 3, true},
+		{`{"a":{"b":{"c":{}}},"d":[]}`, 3, false},
+		{`{"a":{"b":{"c":{}}},"d":[]}`, 4, true},
+		// siblings do not add up
+		{`[[],[],[[]]]`, 3, true},
+		{`[[],[],[[]]]`, 2, false},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		buf.WriteString("x")
+		err := IndentLimit(&buf, []byte(tt.in), "", "\t", tt.maxDepth)
+		if tt.ok {
+			var want bytes.Buffer
+			want.WriteString("x")
+			if err := Indent(&want, []byte(tt.in), "", "\t"); err != nil {
+				t.Fatalf("Indent(%#q): %v", tt.in, err)
+			}
+			if err != nil || buf.String() != want.String() {
+				t.Errorf("IndentLimit(%#q, %d) = %#q, %v, want %#q", tt.in, tt.maxDepth, buf.String(), err, want.String())
+			}
+			continue
+		}
+		var syntaxErr *SyntaxError
+		if !errors.As(err, &syntaxErr) || !strings.Contains(err.Error(), "exceeded max depth") || buf.String() != "x" {
+			t.Errorf("IndentLimit(%#q, %d): got %v, wrote %#q, want exceeded max depth", tt.in, tt.maxDepth, err, buf.String())
+		}
+	}
+
+	// the document is not scanned further once too deep
+	deep := strings.Repeat("[", maxNestingDepth+1)
+	buf.Reset()
+	var syntaxErr *SyntaxError
+	if err := IndentLimit(&buf, []byte(deep), "", "\t", 16); !errors.As(err, &syntaxErr) || syntaxErr.Offset != 17 {
+		t.Errorf("IndentLimit(%d [): got %v, want exceeded max depth at offset 17", len(deep), err)
+	}
+
+	// syntax errors are still reported
+	if err := IndentLimit(&buf, []byte(`[1,]`), "", "\t", 8); !errors.As(err, &syntaxErr) {
+		t.Errorf("IndentLimit(%#q): got %v, want SyntaxError", `[1,]`, err)
+	}
+	if err := IndentLimit(&buf, []byte(`1`), "", "\t", -1); err == nil {
+		t.Errorf("IndentLimit(%#q, -1): got no error", `1`)
+	}
+}
+
+func TestIndentInline(t *testing.T) {
+	tests := []struct {
+		in             string
+		maxInlineWidth int
+		want           string
+	}{
+		{`1`, 80, `1`},
+		{`[1,2,3]`, 80, `[1, 2, 3]`},
+		{` { "a" : [ 1 , 2 ] , "b" : { } } `, 80, `{"a": [1, 2], "b": {}} `},
+		// the width is that of the inline form, with spaces
+		{`[1,2,3]`, 9, `[1, 2, 3]`},
+		{`[1,2,3]`, 8, "[\n\t1,\n\t2,\n\t3\n]"},
+		// only the objects and arrays too wide are expanded
+		{
+			`{"small":[1,2,3],"big":["aaaa","bbbb","cccc"],"obj":{"k":"v"},"empty":[]}`,
+			20,
+			"{\n" +
+				"\t\"small\": [1, 2, 3],\n" +
+				"\t\"big\": [\n" +
+				"\t\t\"aaaa\",\n" +
+				"\t\t\"bbbb\",\n" +
+				"\t\t\"cccc\"\n" +
+				"\t],\n" +
+				"\t\"obj\": {\"k\": \"v\"},\n" +
+				"\t\"empty\": []\n" +
+				"}",
+		},
+		{`[[1,[2]],[3,{"a":4}]]`, 13, "[\n\t[1, [2]],\n\t[3, {\"a\": 4}]\n]"},
+		// punctuation in strings is left as it is
+		{`["a,b:c",{"k,":"]"}]`, 80, `["a,b:c", {"k,": "]"}]`},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		buf.Reset()
+		if err := IndentInline(&buf, []byte(tt.in), "", "\t", tt.maxInlineWidth); err != nil {
+			t.Errorf("IndentInline(%#q, %d): %v", tt.in, tt.maxInlineWidth, err)
+			continue
+		}
+		if buf.String() != tt.want {
+			t.Errorf("IndentInline(%#q, %d) = %q, want %q", tt.in, tt.maxInlineWidth, buf.String(), tt.want)
+		}
+		if !Valid(buf.Bytes()) {
+			t.Errorf("IndentInline(%#q, %d) = %q, want valid JSON", tt.in, tt.maxInlineWidth, buf.String())
+		}
+	}
+
+	// a 50-element array is expanded by default
+	var elems []string
+	for i := range 50 {
+		elems = append(elems, strconv.Itoa(i))
+	}
+	buf.Reset()
+	in := "[" + strings.Join(elems, ",") + "]"
+	if err := IndentInline(&buf, []byte(in), "", "\t", DefaultMaxInlineWidth); err != nil || strings.Count(buf.String(), "\n") != 51 {
+		t.Errorf("IndentInline(%d elements) = %q, %v, want one element per line", len(elems), buf.String(), err)
+	}
+
+	// nothing is kept on one line below the width of an empty object or array, as Indent formats
+	for _, tt := range examples {
+		buf.Reset()
+		if err := IndentInline(&buf, []byte(tt.compact), "", "\t", 1); err != nil {
+			t.Errorf("IndentInline(%#q): %v", tt.compact, err)
+			continue
+		}
+		if buf.String() != tt.indent {
+			t.Errorf("IndentInline(%#q) = %#q, want %#q", tt.compact, buf.String(), tt.indent)
+		}
+	}
+
+	var syntaxErr *SyntaxError
+	for _, in := range []string{`{"a":1`, `[1,]`} {
+		buf.Reset()
+		if err := IndentInline(&buf, []byte(in), "", "\t", DefaultMaxInlineWidth); !errors.As(err, &syntaxErr) || buf.Len() != 0 {
+			t.Errorf("IndentInline(%#q): got %v, wrote %q, want SyntaxError", in, err, buf.String())
+		}
+	}
+}
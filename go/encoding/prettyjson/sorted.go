@@ -0,0 +1,65 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package prettyjson
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+)
+
+// IndentSorted appends to dst an indented form of the JSON-encoded src, as Indent does,
+// with the members of every object sorted by their keys, objects nested in objects or arrays too,
+// such as for canonical output to diff or sign.
+// Keys are compared byte-wise, as encoding/json sorts the keys of maps, and members sharing a key
+// keep their order. Keys and scalar values are left as they are in src, escaped or not.
+// Trailing space characters at the end of src are dropped.
+func IndentSorted(dst *bytes.Buffer, src []byte, prefix, indent string) error {
+	scan := newScanner()
+	defer freeScanner(scan)
+	if err := checkValid(src, scan); err != nil {
+		return err
+	}
+	v, err := parseValue(src)
+	if err != nil {
+		return err
+	}
+	sorted := appendSorted(nil, v)
+	dst.Grow(indentGrowthFactor * len(sorted))
+	b := dst.AvailableBuffer()
+	b, err = appendIndent(b, sorted, prefix, indent, "\n")
+	dst.Write(b)
+	return err
+}
+
+// appendSorted appends to dst the compact form of v, with the members of every object sorted by their keys.
+func appendSorted(dst []byte, v *jsonValue) []byte {
+	if v.kind == 0 {
+		return append(dst, v.raw...)
+	}
+	order := make([]int, len(v.values))
+	for i := range order {
+		order[i] = i
+	}
+	if v.kind == '{' {
+		slices.SortStableFunc(order, func(i, j int) int { return strings.Compare(v.keys[i], v.keys[j]) })
+	}
+
+	dst = append(dst, v.kind)
+	for n, i := range order {
+		if n > 0 {
+			dst = append(dst, ',')
+		}
+		if v.kind == '{' {
+			dst = append(dst, v.rawKeys[i]...)
+			dst = append(dst, ':')
+		}
+		dst = appendSorted(dst, v.values[i])
+	}
+	if v.kind == '{' {
+		return append(dst, '}')
+	}
+	return append(dst, ']')
+}
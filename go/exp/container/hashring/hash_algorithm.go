@@ -9,9 +9,13 @@ import (
 	"encoding/binary"
 	"hash/crc32"
 	"hash/fnv"
+	"sort"
+	"sync"
 )
 
 // HashAlgorithm intents to provide hash for locating a server for a key.
+// A key is located by the first hash returned, while placing a node in the continuum
+// takes every hash returned as one virtual node, as KetamaHash places 4 virtual nodes per MD5 digest.
 type HashAlgorithm interface {
 	// Hash computes the hash for the given key.
 	// @return a positive integer hash
@@ -24,6 +28,68 @@ func (f HashFunc) Hash(k string) []uint32 {
 	return f(k)
 }
 
+// Hash32Func adapts a hash of data into one uint32, such as crc32.ChecksumIEEE, to a HashAlgorithm.
+// It returns one hash per key, so placing a node hashes once per virtual node,
+// without the 4 virtual nodes per MD5 digest of KetamaHash.
+type Hash32Func func(data []byte) uint32
+
+func (f Hash32Func) Hash(k string) []uint32 {
+	return []uint32{f([]byte(k))}
+}
+
+var (
+	hashAlgorithmsMu sync.RWMutex
+	hashAlgorithms   = map[string]HashAlgorithm{
+		"crc":       CRCHash,
+		"crc-perl":  CRCPerlHash,
+		"fnv1-32":   FNV132Hash,
+		"fnv1a-32":  FNV1a32Hash,
+		"fnv1-64":   FNV164Hash,
+		"fnv1a-64":  FNV1a64Hash,
+		"fnv1-128":  FNV1128Hash,
+		"fnv1a-128": FNV1a128Hash,
+		"ketama":    KetamaHash,
+	}
+)
+
+// RegisterHashAlgorithm makes a hash algorithm available by the provided name,
+// such as for configurations naming the algorithm a ring hashes by.
+// The known hashing algorithms are registered as "crc", "crc-perl", "fnv1-32", "fnv1a-32",
+// "fnv1-64", "fnv1a-64", "fnv1-128", "fnv1a-128" and "ketama".
+// If RegisterHashAlgorithm is called twice with the same name or if alg is nil, it panics.
+func RegisterHashAlgorithm(name string, alg HashAlgorithm) {
+	hashAlgorithmsMu.Lock()
+	defer hashAlgorithmsMu.Unlock()
+	if alg == nil {
+		panic("hashring: RegisterHashAlgorithm alg is nil")
+	}
+	if _, dup := hashAlgorithms[name]; dup {
+		panic("hashring: RegisterHashAlgorithm called twice for alg " + name)
+	}
+	hashAlgorithms[name] = alg
+}
+
+// LookupHashAlgorithm returns the hash algorithm registered with the given name.
+//
+// If no hash algorithm is registered with the name, nil will be returned.
+func LookupHashAlgorithm(name string) HashAlgorithm {
+	hashAlgorithmsMu.RLock()
+	defer hashAlgorithmsMu.RUnlock()
+	return hashAlgorithms[name]
+}
+
+// HashAlgorithms returns a sorted list of the names of the registered hash algorithms.
+func HashAlgorithms() []string {
+	hashAlgorithmsMu.RLock()
+	defer hashAlgorithmsMu.RUnlock()
+	list := make([]string, 0, len(hashAlgorithms))
+	for name := range hashAlgorithms {
+		list = append(list, name)
+	}
+	sort.Strings(list)
+	return list
+}
+
 // Known hashing algorithms for locating a server for a key. Note that all hash
 // algorithms return 64-bits of hash, but only the lower 32-bits are
 // significant. This allows a positive 32-bit number to be returned for all
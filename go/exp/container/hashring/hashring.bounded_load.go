@@ -0,0 +1,54 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import "math"
+
+// GetLeastLoaded returns the first node in ring order, start from where name hashes to in the nodes,
+// whose load is under the bound set by WithBoundedLoad, and assigns one more request to it.
+// The bound is ceil(average load * factor), the average load counting the request being assigned.
+// If all nodes are at the bound, as when some nodes have no virtual nodes, the least loaded one is returned.
+// Without WithBoundedLoad, loads are unbounded, so it returns the node Get returns.
+// Done must be called with the node returned when the request completes.
+func (c *HashRing[Node]) GetLeastLoaded(name string) (Node, bool) {
+	var least Node
+	var found bool
+	limit := c.maxLoad()
+	for node := range c.GetSince(name) {
+		if c.loadByNode[node] < limit {
+			least, found = node, true
+			break
+		}
+		if !found || c.loadByNode[node] < c.loadByNode[least] {
+			least, found = node, true
+		}
+	}
+	if found {
+		c.loadByNode[least]++
+		c.totalLoad++
+	}
+	return least, found
+}
+
+// Done releases a request assigned to node by GetLeastLoaded, as it completes.
+func (c *HashRing[Node]) Done(node Node) {
+	if c.loadByNode[node] <= 0 {
+		return
+	}
+	c.loadByNode[node]--
+	if c.loadByNode[node] == 0 {
+		delete(c.loadByNode, node)
+	}
+	c.totalLoad--
+}
+
+// maxLoad returns the load a node is skipped at by GetLeastLoaded.
+func (c *HashRing[Node]) maxLoad() int {
+	if c.loadFactor <= 0 || len(c.allNodes) == 0 {
+		return math.MaxInt
+	}
+	avg := float64(c.totalLoad+1) / float64(len(c.allNodes))
+	return int(math.Ceil(avg * c.loadFactor))
+}
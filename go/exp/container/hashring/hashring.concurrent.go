@@ -0,0 +1,174 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import (
+	"iter"
+	"maps"
+	"sync"
+	"sync/atomic"
+)
+
+// ConcurrentHashRing is a HashRing safe for concurrent use, for read-heavy workloads.
+// Lookups read an immutable snapshot of the ring held in an atomic.Pointer, so they take no lock and never wait,
+// while a mutation copies the current snapshot, changes the copy and swaps it in, serialized with other mutations.
+// Membership changes are thus O(size of the ring), and are expected to be rare.
+//
+// Bounded loads are not supported, as GetLeastLoaded mutates the loads of the ring on every lookup.
+type ConcurrentHashRing[Node comparable] struct {
+	mu   sync.Mutex // serializes mutations
+	ring atomic.Pointer[HashRing[Node]]
+}
+
+// NewConcurrent creates a hash ring safe for concurrent use, as New does.
+func NewConcurrent[Node comparable](opts ...HashRingOption[Node]) *ConcurrentHashRing[Node] {
+	c := &ConcurrentHashRing[Node]{}
+	c.ring.Store(New[Node](opts...))
+	return c
+}
+
+// Snapshot returns the current snapshot of the ring, unchanged by later mutations of c.
+// It must not be mutated, as it is shared by all lookups, and its methods formatting nodes, such as ContainsNode,
+// are not safe for concurrent use, as formatters may cache the keys of nodes.
+func (c *ConcurrentHashRing[Node]) Snapshot() *HashRing[Node] {
+	return c.ring.Load()
+}
+
+// Get returns an element close to where name hashes to in the nodes, as HashRing.Get does.
+func (c *ConcurrentHashRing[Node]) Get(name string) (Node, bool) {
+	return c.ring.Load().Get(name)
+}
+
+// GetBytes returns an element close to where name hashes to in the nodes, as HashRing.GetBytes does.
+func (c *ConcurrentHashRing[Node]) GetBytes(name []byte) (Node, bool) {
+	return c.ring.Load().GetBytes(name)
+}
+
+// GetSince returns an iterator over distinct nodes in hashring, start from where name hashes to in the nodes,
+// as HashRing.GetSince does, all from the snapshot of the ring GetSince is called on.
+func (c *ConcurrentHashRing[Node]) GetSince(name string) iter.Seq[Node] {
+	return c.ring.Load().GetSince(name)
+}
+
+// GetN returns up to n distinct nodes in ring order, as HashRing.GetN does.
+func (c *ConcurrentHashRing[Node]) GetN(name string, n int) []Node {
+	return c.ring.Load().GetN(name, n)
+}
+
+// GetTwo returns the first two distinct nodes in ring order, as HashRing.GetTwo does.
+func (c *ConcurrentHashRing[Node]) GetTwo(name string) (primary, secondary Node, ok, hasSecondary bool) {
+	return c.ring.Load().GetTwo(name)
+}
+
+// All returns an iterator over all nodes in hashring, as HashRing.All does.
+func (c *ConcurrentHashRing[Node]) All() iter.Seq[Node] {
+	return c.ring.Load().All()
+}
+
+// Positions returns an iterator over the hash positions of all virtual nodes with their nodes,
+// as HashRing.Positions does, all from the snapshot of the ring Positions is called on.
+func (c *ConcurrentHashRing[Node]) Positions() iter.Seq2[uint32, Node] {
+	return c.ring.Load().Positions()
+}
+
+// Len returns the number of nodes in hashring, as HashRing.Len does.
+func (c *ConcurrentHashRing[Node]) Len() int {
+	return c.ring.Load().Len()
+}
+
+// ContainsNode reports whether node is in hashring, as HashRing.ContainsNode does.
+// Unlike lookups, it holds the lock of mutations, as formatters may cache the keys of nodes.
+func (c *ConcurrentHashRing[Node]) ContainsNode(node Node) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ring.Load().ContainsNode(node)
+}
+
+// Meta returns the metadata attached to node by SetMeta, as HashRing.Meta does.
+// Unlike lookups, it holds the lock of mutations, as formatters may cache the keys of nodes.
+func (c *ConcurrentHashRing[Node]) Meta(node Node) (meta any, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ring.Load().Meta(node)
+}
+
+// SetMeta attaches meta to node, as HashRing.SetMeta does.
+func (c *ConcurrentHashRing[Node]) SetMeta(node Node, meta any) {
+	c.update(func(r *HashRing[Node]) { r.SetMeta(node, meta) })
+}
+
+// AddNodes inserts nodes into the consistent hash cycle, as HashRing.AddNodes does.
+func (c *ConcurrentHashRing[Node]) AddNodes(nodes ...Node) {
+	c.update(func(r *HashRing[Node]) { r.AddNodes(nodes...) })
+}
+
+// AddNodesWithStats inserts nodes into the consistent hash cycle, and returns how much of the continuum moved,
+// as HashRing.AddNodesWithStats does.
+func (c *ConcurrentHashRing[Node]) AddNodesWithStats(nodes ...Node) (stats RemapStats) {
+	c.update(func(r *HashRing[Node]) { stats = r.AddNodesWithStats(nodes...) })
+	return stats
+}
+
+// AddNodeWithReplicas inserts node with exactly reps virtual nodes, as HashRing.AddNodeWithReplicas does.
+func (c *ConcurrentHashRing[Node]) AddNodeWithReplicas(node Node, reps int) {
+	c.update(func(r *HashRing[Node]) { r.AddNodeWithReplicas(node, reps) })
+}
+
+// SetNodes setups the HashRing with the list of nodes it should use, as HashRing.SetNodes does.
+func (c *ConcurrentHashRing[Node]) SetNodes(nodes ...Node) {
+	c.update(func(r *HashRing[Node]) { r.SetNodes(nodes...) })
+}
+
+// SetWeight sets the weight of node, as HashRing.SetWeight does.
+func (c *ConcurrentHashRing[Node]) SetWeight(node Node, weight int) {
+	c.update(func(r *HashRing[Node]) { r.SetWeight(node, weight) })
+}
+
+// RemoveNodes removes nodes from the consistent hash cycle, and returns the number of nodes removed,
+// as HashRing.RemoveNodes does.
+func (c *ConcurrentHashRing[Node]) RemoveNodes(nodes ...Node) (removed int) {
+	c.update(func(r *HashRing[Node]) { removed = r.RemoveNodes(nodes...) })
+	return removed
+}
+
+// RemoveNodesWithStats removes nodes from the consistent hash cycle, and returns the number of nodes removed
+// and how much of the continuum moved, as HashRing.RemoveNodesWithStats does.
+func (c *ConcurrentHashRing[Node]) RemoveNodesWithStats(nodes ...Node) (removed int, stats RemapStats) {
+	c.update(func(r *HashRing[Node]) { removed, stats = r.RemoveNodesWithStats(nodes...) })
+	return removed, stats
+}
+
+// RemoveNodesFunc removes every node for which pred returns true, and returns the number of nodes removed,
+// as HashRing.RemoveNodesFunc does.
+func (c *ConcurrentHashRing[Node]) RemoveNodesFunc(pred func(Node) bool) (removed int) {
+	c.update(func(r *HashRing[Node]) { removed = r.RemoveNodesFunc(pred) })
+	return removed
+}
+
+// RemoveAllNodes removes all nodes in the continuum, as HashRing.RemoveAllNodes does.
+func (c *ConcurrentHashRing[Node]) RemoveAllNodes() {
+	c.update(func(r *HashRing[Node]) { r.RemoveAllNodes() })
+}
+
+// update applies f to a copy of the current snapshot, and swaps the copy in.
+// The callback of WithOnChange, if any, is called once the copy is swapped in, so that lookups in it
+// locate names in the new ring; it must not mutate c, as the lock of mutations is held.
+func (c *ConcurrentHashRing[Node]) update(f func(r *HashRing[Node])) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	r := c.ring.Load().Clone()
+	onChange := r.onChange
+	if onChange == nil {
+		f(r)
+		c.ring.Store(r)
+		return
+	}
+	before := maps.Clone(r.allNodes)
+	r.onChange = nil
+	f(r)
+	r.onChange = onChange
+	c.ring.Store(r)
+	r.notifyChange(before)
+}
@@ -0,0 +1,44 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import "math"
+
+// Distribution returns the number of sampleKeys each node owns, as Get locates them,
+// with 0 for the nodes owning none, such as to check that a weight map places names in balance.
+// It does not mutate c.
+func (c *HashRing[Node]) Distribution(sampleKeys []string) map[Node]int {
+	counts := make(map[Node]int, len(c.allNodes))
+	for node := range c.allNodes {
+		counts[node] = 0
+	}
+	for _, key := range sampleKeys {
+		if node, ok := c.Get(key); ok {
+			counts[node]++
+		}
+	}
+	return counts
+}
+
+// LoadStdDev returns the population standard deviation of the number of sampleKeys each node owns,
+// as Distribution counts them, 0 if c is empty.
+// The weights of nodes are not taken into account: the deviation of a weighted ring
+// measures how far it is from an even spread.
+func (c *HashRing[Node]) LoadStdDev(sampleKeys []string) float64 {
+	counts := c.Distribution(sampleKeys)
+	if len(counts) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, n := range counts {
+		sum += float64(n)
+	}
+	mean := sum / float64(len(counts))
+	var variance float64
+	for _, n := range counts {
+		variance += (float64(n) - mean) * (float64(n) - mean)
+	}
+	return math.Sqrt(variance / float64(len(counts)))
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// WriteDOT writes the continuum to w as a Graphviz DOT graph, such as to understand skew when a test fails:
+// the hash positions of all virtual nodes in ascending order form a cycle, each labeled with its position
+// and its node, as formatted by %v, and filled with the color of its node.
+// Nodes get evenly spaced hues in the order of their keys, so that the same ring always renders the same.
+func (c *HashRing[Node]) WriteDOT(w io.Writer) error {
+	colors := make(map[Node]string, len(c.allNodes))
+	nodes := c.sortedNodes(c.getAllNodes())
+	for i, node := range nodes {
+		colors[node] = fmt.Sprintf("%.3f 0.400 1.000", float64(i)/float64(len(nodes)))
+	}
+
+	var b bytes.Buffer
+	b.WriteString("digraph hashring {\n")
+	if len(c.sortedKeys) > 0 {
+		b.WriteString("\tnode [shape=box, style=filled];\n")
+	}
+	for i, k := range c.sortedKeys {
+		node := c.nodeByKey[k]
+		fmt.Fprintf(&b, "\tp%d [label=%s, fillcolor=%q];\n",
+			i, strconv.Quote(fmt.Sprintf("%d\n%v", k, node)), colors[node])
+	}
+	for i := range c.sortedKeys {
+		fmt.Fprintf(&b, "\tp%d -> p%d;\n", i, (i+1)%len(c.sortedKeys))
+	}
+	b.WriteString("}\n")
+	_, err := w.Write(b.Bytes())
+	return err
+}
@@ -0,0 +1,38 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import "math"
+
+// EstimateNodesForBalance returns the least number of nodes, of numReps virtual nodes each, for the node owning
+// the most keys to own about targetMaxShare of them at most, such as to size a cluster before deploying it.
+// numReps of 0 or less is taken as the default number of virtual nodes, 160.
+// It returns 0 if targetMaxShare is not positive, as no number of nodes reaches it.
+//
+// It is an approximation, not a bound: the share of a node is the sum of the arcs of its numReps virtual nodes,
+// of mean 1/n and standard deviation about 1/(n*sqrt(numReps)) for n nodes, and the largest share of n nodes is
+// estimated as mean + standard deviation * sqrt(2*ln(n)), as for the maximum of n normal variables.
+// Actual rings deviate from it, by their hash algorithm and the names of their nodes;
+// Distribution measures a ring built for real.
+func EstimateNodesForBalance(targetMaxShare float64, numReps int) int {
+	if !(targetMaxShare > 0) {
+		return 0
+	}
+	if numReps <= 0 {
+		numReps = defaultNumReps
+	}
+	// no fewer nodes than an even spread requires
+	n := max(int(math.Ceil(1/targetMaxShare-1e-9)), 1)
+	for estimateMaxShare(n, numReps) > targetMaxShare {
+		n++
+	}
+	return n
+}
+
+// estimateMaxShare returns the estimated share of the node owning the most keys among n nodes
+// of numReps virtual nodes each.
+func estimateMaxShare(n, numReps int) float64 {
+	return (1 + math.Sqrt(2*math.Log(float64(n))/float64(numReps))) / float64(n)
+}
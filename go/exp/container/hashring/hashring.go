@@ -24,6 +24,8 @@ import (
 	"maps"
 	"math"
 	"slices"
+
+	unsafe_ "github.com/searKing/golang/go/unsafe"
 )
 
 const defaultNumReps = 160
@@ -54,6 +56,21 @@ type HashRing[Node comparable] struct {
 
 	// The hash algorithm to use when choosing a node in the Ketama consistent hash continuum
 	hashAlg HashAlgorithm
+	// The hash algorithm to use when placing virtual nodes in the continuum, hashAlg is used if nil
+	nodeHashAlg HashAlgorithm
+	// choose the closer one of the two virtual nodes around a key, instead of the next one clockwise
+	nearest bool
+	// prepended to names before hashing, namespacing names of rings sharing the same nodes
+	keyPrefix string `option:"-"`
+
+	// bounded loads, a node is skipped by GetLeastLoaded once its load reaches ceil(average load * loadFactor),
+	// no bound if 0
+	loadFactor float64      `option:"-"`
+	loadByNode map[Node]int `option:"-"` // requests assigned by GetLeastLoaded and not Done
+	totalLoad  int          `option:"-"`
+
+	// called with the net change of nodes once a mutation completes, not called if nil
+	onChange func(added, removed []Node) `option:"-"`
 
 	// node weights for ketama, a map from InetSocketAddress to weight as Integer
 	weightByNode map[Node]int
@@ -61,6 +78,10 @@ type HashRing[Node comparable] struct {
 
 	// the number of discrete hashes that should be defined for each node in the continuum.
 	numReps int
+	// the number of virtual nodes of the nodes added by AddNodeWithReplicas, taking precedence over numReps and weights
+	repsByNode map[Node]int `option:"-"`
+	// opaque metadata of nodes set by SetMeta, dropped once the node is removed
+	metaByNode map[Node]any `option:"-"`
 	// the format used to name the nodes in Ketama, either SpyMemcached or LibMemcached
 	nodeKeyFormatter Formatter[Node]
 }
@@ -72,7 +93,10 @@ func New[Node comparable](opts ...HashRingOption[Node]) *HashRing[Node] {
 		allNodes:         make(map[Node]struct{}),
 		hashAlg:          KetamaHash,
 		weightByNode:     make(map[Node]int),
+		loadByNode:       make(map[Node]int),
 		numReps:          defaultNumReps,
+		repsByNode:       make(map[Node]int),
+		metaByNode:       make(map[Node]any),
 		nodeKeyFormatter: NewKetamaNodeKeyFormatter[Node](SpyMemcached),
 	}
 	r.ApplyOptions(opts...)
@@ -85,6 +109,13 @@ func New[Node comparable](opts ...HashRingOption[Node]) *HashRing[Node] {
 
 // AddNodes inserts nodes into the consistent hash cycle.
 func (c *HashRing[Node]) AddNodes(nodes ...Node) {
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
+	c.addNodes(nodes...)
+}
+
+func (c *HashRing[Node]) addNodes(nodes ...Node) {
 	if c.isWeighted {
 		c.addWeightNodes(nodes...)
 		return
@@ -97,15 +128,122 @@ func (c *HashRing[Node]) AddNodes(nodes ...Node) {
 // @param nodes a List of Nodes for this HashRing to use in
 // its continuum
 func (c *HashRing[Node]) SetNodes(nodes ...Node) {
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
 	if c.isWeighted {
 		c.setWeightNodes(nodes...)
-		return
+	} else {
+		c.setNoWeightNodes(nodes...)
+	}
+	c.pruneRemoved()
+}
+
+// AddNodeWithReplicas inserts node into the consistent hash cycle with exactly reps virtual nodes,
+// or places node again if present, such as to give a more powerful node more virtual nodes directly,
+// without the rounding of weights.
+// The number of virtual nodes of node takes precedence over the one of WithNumReplicas and over weights:
+// in a weighted ring, the other nodes share the virtual nodes by their weights as if node was absent.
+// It lasts until node is removed. A node of 0 virtual nodes or less stays in the ring, but is never chosen.
+func (c *HashRing[Node]) AddNodeWithReplicas(node Node, reps int) {
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
+	reps = max(reps, 0)
+	if c.isWeighted {
+		if n, has := c.getNode(node); has {
+			delete(c.allNodes, n)
+		}
+		c.repsByNode[node] = reps
+		c.setWeightNodes(append(c.getAllNodes(), node)...)
+	} else {
+		if n, has := c.getNode(node); has {
+			c.removeNoWeightNodes(n)
+		}
+		c.repsByNode[node] = reps
+		c.addNodeWithoutSort(node, reps)
+		c.updateSortedNodes()
+	}
+	c.pruneRemoved()
+}
+
+// SetWeight sets the weight of node, adding node if absent, and re-distributes the virtual nodes of all nodes,
+// as the share of a node is its weight relative to the total weight.
+// A ring not weighted becomes weighted, with a weight of 1 for each node without one,
+// so that the nodes keep sharing the continuum equally but for node.
+// A node of weight 0 stays in the ring, but with no virtual nodes, so that it is never chosen.
+func (c *HashRing[Node]) SetWeight(node Node, weight int) {
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
+	if !c.isWeighted {
+		c.isWeighted = true
+		for n := range c.allNodes {
+			if _, has := c.weightByNode[n]; !has {
+				c.weightByNode[n] = 1
+			}
+		}
+	}
+	c.weightByNode[node] = max(weight, 0)
+	nodes := c.getAllNodes()
+	if _, has := c.allNodes[node]; !has {
+		nodes = append(nodes, node)
+	}
+	c.setWeightNodes(nodes...)
+}
+
+// SetMeta attaches meta to node, replacing the metadata set before, if any, such as to carry the datacenter
+// or the health of a node alongside it, without changing the key it is hashed by.
+// The metadata survives adding and removing other nodes, and is dropped once node is removed.
+// SetMeta is a no-op if node is not in hashring.
+func (c *HashRing[Node]) SetMeta(node Node, meta any) {
+	if n, has := c.getNode(node); has {
+		c.metaByNode[n] = meta
+	}
+}
+
+// Meta returns the metadata attached to node by SetMeta, such as to annotate the node returned by Get,
+// and ok false if none was attached, or node is not in hashring.
+func (c *HashRing[Node]) Meta(node Node) (meta any, ok bool) {
+	n, has := c.getNode(node)
+	if !has {
+		return nil, false
+	}
+	meta, ok = c.metaByNode[n]
+	return meta, ok
+}
+
+// Clone returns a copy of c, independent of c, such as to build a candidate ring with proposed nodes
+// and compare it with the current one before swapping them.
+// The nodes, their weights, virtual nodes and metadata are copied, the metadata values shallowly,
+// as is the formatter if it is a KetamaNodeKeyFormatter, while hash algorithms and other formatters, expected to be stateless, are shared, as is the callback of WithOnChange.
+func (c *HashRing[Node]) Clone() *HashRing[Node] {
+	r := *c
+	r.sortedKeys = slices.Clone(c.sortedKeys)
+	r.nodeByKey = maps.Clone(c.nodeByKey)
+	r.allNodes = maps.Clone(c.allNodes)
+	r.weightByNode = maps.Clone(c.weightByNode)
+	r.loadByNode = maps.Clone(c.loadByNode)
+	r.repsByNode = maps.Clone(c.repsByNode)
+	r.metaByNode = maps.Clone(c.metaByNode)
+	switch f := c.nodeKeyFormatter.(type) {
+	case *KetamaNodeKeyFormatter[Node]:
+		r.nodeKeyFormatter = NewKetamaNodeKeyFormatter[Node](f.GetFormat())
 	}
-	c.setNoWeightNodes(nodes...)
+	return &r
 }
 
 // RemoveAllNodes removes all nodes in the continuum.
 func (c *HashRing[Node]) RemoveAllNodes() {
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
+	c.removeAllNodes()
+	clear(c.repsByNode)
+	clear(c.metaByNode)
+}
+
+func (c *HashRing[Node]) removeAllNodes() {
 	c.sortedKeys = nil
 	c.nodeByKey = make(map[uint32]Node)
 	c.allNodes = make(map[Node]struct{})
@@ -120,6 +258,25 @@ func (c *HashRing[Node]) Get(name string) (Node, bool) {
 	return c.getPrimaryNode(name)
 }
 
+// GetBytes returns an element close to where name hashes to in the nodes, as Get(string(name)) does,
+// but without converting name into a string, so that it does not allocate for the conversion.
+// The hash algorithm must not retain the key it hashes, as the key shares the memory of name.
+func (c *HashRing[Node]) GetBytes(name []byte) (Node, bool) {
+	return c.Get(unsafe_.BytesToString(name))
+}
+
+// GetKey returns an element close to where name hashes to in the nodes of c,
+// as c.Get(string(name)) does, without converting name if it is a string or a []byte.
+func GetKey[Node comparable, K ~string | ~[]byte](c *HashRing[Node], name K) (Node, bool) {
+	switch name := any(name).(type) {
+	case string:
+		return c.Get(name)
+	case []byte:
+		return c.GetBytes(name)
+	}
+	return c.Get(string(name))
+}
+
 // GetSince returns an iterator over distinct nodes in hashring, start from where name hashes to in the nodes.
 func (c *HashRing[Node]) GetSince(name string) iter.Seq[Node] {
 	return func(yield func(Node) bool) {
@@ -127,10 +284,7 @@ func (c *HashRing[Node]) GetSince(name string) iter.Seq[Node] {
 			return
 		}
 
-		firstKey, found := c.tailSearch(c.getHashKey(name))
-		if !found {
-			firstKey = 0
-		}
+		firstKey := c.searchHashKeyIndex(c.getHashKey(name))
 		firstNode := c.getNodeByHashKeyIndex(firstKey)
 
 		if !yield(firstNode) {
@@ -160,12 +314,105 @@ func (c *HashRing[Node]) GetSince(name string) iter.Seq[Node] {
 	}
 }
 
+// GetN returns up to n distinct nodes in ring order, start from where name hashes to in the nodes,
+// such as the replicas of name, with the one Get returns first.
+// It returns all nodes if there are fewer than n, and an empty slice if c is empty or n <= 0.
+func (c *HashRing[Node]) GetN(name string, n int) []Node {
+	n = min(n, len(c.allNodes))
+	if n <= 0 || len(c.nodeByKey) == 0 {
+		return nil
+	}
+	nodes := make([]Node, 0, n)
+	for node := range c.GetSince(name) {
+		nodes = append(nodes, node)
+		if len(nodes) == n {
+			break
+		}
+	}
+	return nodes
+}
+
+// GetTwo returns the first two distinct nodes in ring order, start from where name hashes to in the nodes,
+// such as the primary and secondary owners of name, as GetN(name, 2) does, but without allocating a slice,
+// so that it allocates no more than Get.
+// ok is false if c is empty; hasSecondary is false if c holds only one node, secondary equals primary then.
+func (c *HashRing[Node]) GetTwo(name string) (primary, secondary Node, ok, hasSecondary bool) {
+	if len(c.nodeByKey) == 0 {
+		return primary, secondary, false, false
+	}
+	first := c.searchHashKeyIndex(c.getHashKey(name))
+	primary = c.getNodeByHashKeyIndex(first)
+	if len(c.allNodes) > 1 {
+		for i := (first + 1) % len(c.sortedKeys); i != first; i = (i + 1) % len(c.sortedKeys) {
+			if secondary = c.getNodeByHashKeyIndex(i); secondary != primary {
+				return primary, secondary, true, true
+			}
+		}
+	}
+	return primary, primary, true, false
+}
+
 // All returns an iterator over all nodes in hashring.
 // If c is empty, the sequence is empty: there is no empty element in the sequence.
 func (c *HashRing[Node]) All() iter.Seq[Node] {
 	return maps.Keys(c.allNodes)
 }
 
+// Positions returns an iterator over the hash positions of all virtual nodes in the continuum, in ascending order,
+// with the nodes owning them, such as to visualize hashring.
+// If c is empty, the sequence is empty.
+func (c *HashRing[Node]) Positions() iter.Seq2[uint32, Node] {
+	return func(yield func(uint32, Node) bool) {
+		for _, k := range c.sortedKeys {
+			if !yield(k, c.nodeByKey[k]) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of nodes in hashring, not counting their virtual nodes.
+func (c *HashRing[Node]) Len() int {
+	return len(c.allNodes)
+}
+
+// ContainsNode reports whether node is in hashring.
+// Nodes are the same if they format to the same key, as the positions of their virtual nodes are.
+func (c *HashRing[Node]) ContainsNode(node Node) bool {
+	_, has := c.getNode(node)
+	return has
+}
+
+// Replicas returns the hash positions of the virtual nodes of node in the continuum, in ascending order,
+// such as to diagnose hot spots, or nil if node is not in hashring.
+func (c *HashRing[Node]) Replicas(node Node) []uint32 {
+	node, has := c.getNode(node)
+	if !has {
+		return nil
+	}
+	var positions []uint32
+	for _, k := range c.sortedKeys {
+		if c.nodeByKey[k] == node {
+			positions = append(positions, k)
+		}
+	}
+	return positions
+}
+
+// getNode returns the node in hashring the same as node.
+func (c *HashRing[Node]) getNode(node Node) (Node, bool) {
+	if _, has := c.allNodes[node]; has {
+		return node, true
+	}
+	for n := range c.allNodes {
+		if c.isSameNode(n, node) {
+			return n, true
+		}
+	}
+	var zeroN Node
+	return zeroN, false
+}
+
 // getAllNodes returns all available nodes
 func (c *HashRing[Node]) getAllNodes() []Node {
 	return slices.Collect(maps.Keys(c.allNodes))
@@ -196,11 +443,30 @@ func (c *HashRing[Node]) getNodeByHashKey(hash uint32) (Node, bool) {
 	if has {
 		return rv, true
 	}
-	firstKey, found := c.tailSearch(hash)
+	return c.getNodeByHashKeyIndex(c.searchHashKeyIndex(hash)), true
+}
+
+// searchHashKeyIndex returns the index of sorted hash keys the hash belongs to,
+// that is the first one since hash clockwise, or the closer one of the two around hash if nearest is set.
+func (c *HashRing[Node]) searchHashKeyIndex(hash uint32) int {
+	next, found := c.tailSearch(hash)
 	if !found {
-		firstKey = 0
+		next = 0
+	}
+	if !c.nearest {
+		return next
+	}
+	prev := next - 1
+	if prev < 0 {
+		prev = len(c.sortedKeys) - 1
+	}
+	// distances wrap around the continuum, as uint32 does
+	dp := hash - c.sortedKeys[prev]
+	dn := c.sortedKeys[next] - hash
+	if dp <= dn {
+		return prev
 	}
-	return c.getNodeByHashKeyIndex(firstKey), true
+	return next
 }
 
 // getNodeByHashKeyIndex returns the node by index of sorted hash keys.
@@ -219,41 +485,58 @@ func (c *HashRing[Node]) getNodeRepetitions() int {
 	return c.numReps
 }
 
+// getNodeRepetitionsOf returns the number of discrete hashes that should be defined for node
+// in the continuum not weighted, as set by AddNodeWithReplicas, or for each node.
+func (c *HashRing[Node]) getNodeRepetitionsOf(node Node) int {
+	if reps, has := c.repsByNode[node]; has {
+		return reps
+	}
+	return c.getNodeRepetitions()
+}
+
+// pruneRemoved forgets the numbers of virtual nodes set by AddNodeWithReplicas, and the metadata set by SetMeta,
+// of the nodes removed.
+func (c *HashRing[Node]) pruneRemoved() {
+	maps.DeleteFunc(c.repsByNode, func(node Node, _ int) bool {
+		_, has := c.allNodes[node]
+		return !has
+	})
+	maps.DeleteFunc(c.metaByNode, func(node Node, _ any) bool {
+		_, has := c.allNodes[node]
+		return !has
+	})
+}
+
 // setNoWeightNodes sets all the elements in the hash.
+// Nodes are matched by their keys formatted with repetition 0, as isSameNode does, looked up in sets
+// so that the membership diff is O(n), not O(n²).
 func (c *HashRing[Node]) setNoWeightNodes(nodes ...Node) {
 	// Set sets all the elements in the hash.
 	// If there are existing elements not present in nodes, they will be removed.
+	keys := make(map[string]struct{}, len(nodes))
+	for _, v := range nodes {
+		keys[c.nodeKeyFormatter.FormatNodeKey(v, 0)] = struct{}{}
+	}
 	var nodesToBeRemoved []Node
 	// remove missing Nodes
 	for k := range c.allNodes {
-		var found bool
-		for _, v := range nodes {
-			if c.isSameNode(k, v) {
-				// found
-				found = true
-				break
-			}
-		}
-		if !found {
+		if _, found := keys[c.nodeKeyFormatter.FormatNodeKey(k, 0)]; !found {
 			nodesToBeRemoved = append(nodesToBeRemoved, k)
 		}
 	}
 	if len(nodesToBeRemoved) == len(nodes) {
-		c.RemoveAllNodes()
+		c.removeAllNodes()
 	} else {
 		c.removeNoWeightNodes(nodesToBeRemoved...)
 	}
 	// add all missing elements present in nodes.
+	clear(keys)
+	for v := range c.allNodes {
+		keys[c.nodeKeyFormatter.FormatNodeKey(v, 0)] = struct{}{}
+	}
 	var nodesToBeAdded []Node
 	for _, k := range nodes {
-		var found bool
-		for v := range c.allNodes {
-			if c.isSameNode(k, v) {
-				found = true
-				break
-			}
-		}
-		if !found {
+		if _, found := keys[c.nodeKeyFormatter.FormatNodeKey(k, 0)]; !found {
 			nodesToBeAdded = append(nodesToBeAdded, k)
 		}
 	}
@@ -262,21 +545,33 @@ func (c *HashRing[Node]) setNoWeightNodes(nodes ...Node) {
 
 // setWeightNodes sets all the elements in the hash.
 func (c *HashRing[Node]) setWeightNodes(nodes ...Node) {
-	c.RemoveAllNodes()
+	c.removeAllNodes()
 	numReps := c.getNodeRepetitions()
-	nodeCount := len(nodes)
+	nodeCount := 0
 	totalWeight := 0
 
+	// the nodes added by AddNodeWithReplicas are not weighted
 	for _, node := range nodes {
+		if _, has := c.repsByNode[node]; has {
+			continue
+		}
+		nodeCount++
 		totalWeight += c.weightByNode[node]
 	}
 
 	// add all elements present in nodes.
 	for _, node := range nodes {
-		thisWeight := c.weightByNode[node]
-		percent := float64(thisWeight) / float64(totalWeight)
-		// floor(percent * numReps * nodeCount + 1e10)
-		pointerPerServer := (int)(math.Floor(percent*(float64(numReps))*float64(nodeCount) + 1e10))
+		if reps, has := c.repsByNode[node]; has {
+			c.addNodeWithoutSort(node, reps)
+			continue
+		}
+		var percent float64
+		if totalWeight > 0 {
+			percent = float64(c.weightByNode[node]) / float64(totalWeight)
+		}
+		// floor(percent * numReps * nodeCount + 1e-10), the epsilon absorbs float rounding below an integer,
+		// so that the virtual nodes are shared in proportion to the weights.
+		pointerPerServer := (int)(math.Floor(percent*(float64(numReps))*float64(nodeCount) + 1e-10))
 		c.addNodeWithoutSort(node, pointerPerServer)
 	}
 
@@ -291,10 +586,8 @@ func (c *HashRing[Node]) addWeightNodes(nodes ...Node) {
 
 // addNoWeightNodes adds a node to the hash without sorting the keys.
 func (c *HashRing[Node]) addNoWeightNodes(nodes ...Node) {
-	numReps := c.getNodeRepetitions()
-
 	for _, node := range nodes {
-		c.addNodeWithoutSort(node, numReps)
+		c.addNodeWithoutSort(node, c.getNodeRepetitionsOf(node))
 	}
 
 	c.updateSortedNodes()
@@ -318,7 +611,7 @@ func (c *HashRing[Node]) addNodeWithoutSort(node Node, numReps int) {
 		}
 
 		for j, pos := range positions {
-			if i+j > numReps { // out of bound
+			if i+j >= numReps { // out of bound
 				break
 			}
 			if _, has := c.nodeByKey[pos]; has {
@@ -334,13 +627,49 @@ func (c *HashRing[Node]) addNodeWithoutSort(node Node, numReps int) {
 	c.allNodes[node] = struct{}{}
 }
 
-// RemoveNodes removes nodes from the consistent hash cycle
-func (c *HashRing[Node]) RemoveNodes(nodes ...Node) {
+// RemoveNodes removes nodes from the consistent hash cycle,
+// and returns the number of nodes removed, not counting the nodes not in the cycle, as ContainsNode reports.
+// If none of nodes is in the cycle, the cycle is left untouched.
+func (c *HashRing[Node]) RemoveNodes(nodes ...Node) int {
+	var removing []Node
+	for _, node := range nodes {
+		if n, has := c.getNode(node); has && !slices.Contains(removing, n) {
+			removing = append(removing, n)
+		}
+	}
+	return c.removeNodes(removing...)
+}
+
+// RemoveNodesFunc removes every node for which pred returns true from the consistent hash cycle,
+// such as all the nodes of a drained zone, and returns the number of nodes removed.
+// pred is called once for each node, in no particular order, and must not mutate c.
+// The cycle is rebuilt once for all the nodes removed, and left untouched if none is.
+func (c *HashRing[Node]) RemoveNodesFunc(pred func(Node) bool) int {
+	var removing []Node
+	for n := range c.allNodes {
+		if pred(n) {
+			removing = append(removing, n)
+		}
+	}
+	return c.removeNodes(removing...)
+}
+
+// removeNodes removes nodes, all distinct and in the cycle, from the consistent hash cycle,
+// and returns the number of nodes removed.
+func (c *HashRing[Node]) removeNodes(nodes ...Node) int {
+	if len(nodes) == 0 {
+		return 0
+	}
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
 	if c.isWeighted {
 		c.removeWeightNodes(nodes...)
-		return
+	} else {
+		c.removeNoWeightNodes(nodes...)
 	}
-	c.removeNoWeightNodes(nodes...)
+	c.pruneRemoved()
+	return len(nodes)
 }
 
 // removeWeightNodes removes nodes from the consistent hash cycle
@@ -352,9 +681,8 @@ func (c *HashRing[Node]) removeWeightNodes(nodes ...Node) {
 }
 
 func (c *HashRing[Node]) removeNoWeightNodes(nodes ...Node) {
-	numReps := c.getNodeRepetitions()
-
 	for _, node := range nodes {
+		numReps := c.getNodeRepetitionsOf(node)
 		for i := 0; i < numReps; {
 			positions := c.getIterateHashKeyForNode(node, i)
 			if len(positions) == 0 {
@@ -365,7 +693,7 @@ func (c *HashRing[Node]) removeNoWeightNodes(nodes ...Node) {
 			}
 
 			for j, pos := range positions {
-				if i+j > numReps { // out of bound
+				if i+j >= numReps { // out of bound
 					break
 				}
 				if n, has := c.nodeByKey[pos]; has {
@@ -383,15 +711,12 @@ func (c *HashRing[Node]) removeNoWeightNodes(nodes ...Node) {
 	c.updateSortedNodes()
 }
 
-// tailSearch returns the first available node since iterateHashKey's Index, such as Index(HASH(“127.0.0.1:11311-0”))
+// tailSearch returns the index of the first hash key not less than key, such as Index(HASH(“127.0.0.1:11311-0”)),
+// that is the position key is placed at or next to clockwise, found false if key is past the last position,
+// for callers to wrap around to the first one.
 func (c *HashRing[Node]) tailSearch(key uint32) (i int, found bool) {
-	// Search uses binary search to find and return the smallest index since iterateHashKey's Index
-	return slices.BinarySearchFunc(c.sortedKeys, key, func(v uint32, key uint32) int {
-		if v >= key {
-			return 0
-		}
-		return -1
-	})
+	i, _ = slices.BinarySearch(c.sortedKeys, key)
+	return i, i < len(c.sortedKeys)
 }
 
 // updateSortedNodes sorts the keys in ascending order.
@@ -410,6 +735,26 @@ func (c *HashRing[Node]) updateSortedNodes() {
 	c.sortedKeys = hashes
 }
 
+// notifyChange calls onChange with the nodes added to and removed from hashring since before,
+// the nodes hashring held, unless the nodes are unchanged.
+func (c *HashRing[Node]) notifyChange(before map[Node]struct{}) {
+	var added, removed []Node
+	for node := range c.allNodes {
+		if _, has := before[node]; !has {
+			added = append(added, node)
+		}
+	}
+	for node := range before {
+		if _, has := c.allNodes[node]; !has {
+			removed = append(removed, node)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+	c.onChange(added, removed)
+}
+
 // isSameNode checks if two nodes are the same by the key.
 func (c *HashRing[Node]) isSameNode(n1, n2 Node) bool {
 	return c.nodeKeyFormatter.FormatNodeKey(n1, 0) == c.nodeKeyFormatter.FormatNodeKey(n2, 0)
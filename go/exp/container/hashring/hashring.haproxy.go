@@ -0,0 +1,91 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import (
+	"strconv"
+)
+
+// HAProxy's consistent hashing, as "hash-type consistent" of HAProxy 1.5 to 2.3,
+// see src/lb_chash.c and src/hash.c of HAProxy.
+//
+// Each server with weight w is placed at w*16 points of the continuum,
+// HASH(puid*4096 + i) for i in [0, w*16), where HASH is full_hash, one of Bob Jenkins' full avalanche
+// integer hashing functions, and puid is the numeric id of the server, the "id" keyword, or
+// its 1-based position in the backend if unset.
+// A key is hashed by sdbm, the default hash function, with no "avalanche" modifier,
+// and goes to the server of the closer one of the two points around the key hash.
+const (
+	haproxyBackendWeightScale  = 16                                                   // BE_WEIGHT_SCALE
+	haproxyServerWeightRange   = 256                                                  // SRV_UWGHT_RANGE
+	haproxyServerEWeightRange  = haproxyServerWeightRange * haproxyBackendWeightScale // SRV_EWGHT_RANGE
+	haproxyServerDefaultWeight = 1
+)
+
+var (
+	// HAProxySDBMHash hash algorithm by sdbm, as hash_sdbm of HAProxy.
+	HAProxySDBMHash = HashFunc(haproxySDBMHash)
+	// HAProxyNodeHash hash algorithm by full_hash of HAProxy, k must be the decimal representation of uint32,
+	// as formatted by HAProxyNodeKeyFormatter.
+	HAProxyNodeHash = HashFunc(haproxyNodeHash)
+)
+
+// HAProxyNodeKeyFormatter returns a Formatter formats the repetition of node as HAProxy does,
+// that is puid*4096 + repetition in decimal.
+// puid returns the numeric id of node, as the "id" keyword of a server in HAProxy.
+func HAProxyNodeKeyFormatter[Node comparable](puid func(node Node) int) Formatter[Node] {
+	return FormatterFunc[Node](func(node Node, repetition int) string {
+		return strconv.FormatUint(uint64(uint32(puid(node)*haproxyServerEWeightRange+repetition)), 10)
+	})
+}
+
+// WithHAProxyCompat makes HashRing locate nodes as HAProxy's "hash-type consistent" with the default sdbm
+// hash function and server weight 1, so that keys are routed identically by HashRing and HAProxy.
+// puid returns the numeric id of node, as the "id" keyword of a server in HAProxy,
+// or the 1-based position of the server in the backend if unset.
+//
+// Server weights and hash functions other than sdbm of HAProxy are not supported.
+func WithHAProxyCompat[Node comparable](puid func(node Node) int) HashRingOption[Node] {
+	return HashRingOptionFunc[Node](func(r *HashRing[Node]) {
+		r.hashAlg = HAProxySDBMHash
+		r.nodeHashAlg = HAProxyNodeHash
+		r.nodeKeyFormatter = HAProxyNodeKeyFormatter(puid)
+		r.numReps = haproxyServerDefaultWeight * haproxyBackendWeightScale
+		r.nearest = true
+	})
+}
+
+// hash_sdbm of HAProxy, bytes are sign-extended as char is signed in C on most platforms.
+func haproxySDBMHash(k string) []uint32 {
+	var hash uint32
+	for i := 0; i < len(k); i++ {
+		c := uint32(int32(int8(k[i])))
+		hash = c + (hash << 6) + (hash << 16) - hash
+	}
+	return []uint32{hash}
+}
+
+func haproxyNodeHash(k string) []uint32 {
+	a, err := strconv.ParseUint(k, 10, 32)
+	if err != nil {
+		return nil
+	}
+	return []uint32{haproxyFullHash(uint32(a))}
+}
+
+// full_hash of HAProxy, one of Bob Jenkins' full avalanche hashing functions,
+// see http://burtleburtle.net/bob/hash/integer.html
+func haproxyFullHash(a uint32) uint32 {
+	a = (a + 0x7ed55d16) + (a << 12)
+	a = (a ^ 0xc761c23c) ^ (a >> 19)
+	a = (a + 0x165667b1) + (a << 5)
+	a = (a + 0xd3a2646c) ^ (a << 9)
+	a = (a + 0xfd7046c5) + (a << 3)
+	a = (a ^ 0xb55a4f09) ^ (a >> 16)
+
+	// ensure values are better spread all around the tree by multiplying
+	// by a large prime close to 3/4 of the tree.
+	return a * 3221225473
+}
@@ -0,0 +1,113 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+)
+
+var (
+	_ json.Marshaler   = (*HashRing[string])(nil)
+	_ json.Unmarshaler = (*HashRing[string])(nil)
+)
+
+// hashRingJSON is the configuration of HashRing persisted in JSON.
+type hashRingJSON[Node comparable] struct {
+	Nodes      []Node             `json:"nodes"`
+	Weights    []nodeWeight[Node] `json:"weights,omitempty"`
+	Replicas   []nodeWeight[Node] `json:"replicas,omitempty"` // the numbers of virtual nodes set by AddNodeWithReplicas
+	NumReps    int                `json:"num_reps"`
+	IsWeighted bool               `json:"is_weighted"`
+	// the format of KetamaNodeKeyFormatter, nil if the formatter is of another kind
+	Format *Format `json:"format,omitempty"`
+}
+
+type nodeWeight[Node comparable] struct {
+	Node   Node `json:"node"`
+	Weight int  `json:"weight"`
+}
+
+// MarshalJSON marshals the configuration of c, that is the nodes, their weights, the number of virtual nodes
+// per node and of the nodes added by AddNodeWithReplicas, whether c is weighted and the format of
+// KetamaNodeKeyFormatter, so that a ring restored by UnmarshalJSON places nodes identically.
+// Nodes are ordered by their keys, as formatted for the first virtual node.
+//
+// The hash algorithms and formatters other than KetamaNodeKeyFormatter are not marshaled,
+// they must match on both ends for the restored ring to locate keys identically.
+// Nor is the metadata set by SetMeta, opaque to c.
+func (c *HashRing[Node]) MarshalJSON() ([]byte, error) {
+	cfg := hashRingJSON[Node]{
+		Nodes:      c.sortedNodes(c.getAllNodes()),
+		NumReps:    c.numReps,
+		IsWeighted: c.isWeighted,
+	}
+	if c.isWeighted {
+		for _, node := range c.sortedNodes(slices.Collect(maps.Keys(c.weightByNode))) {
+			cfg.Weights = append(cfg.Weights, nodeWeight[Node]{Node: node, Weight: c.weightByNode[node]})
+		}
+	}
+	for _, node := range c.sortedNodes(slices.Collect(maps.Keys(c.repsByNode))) {
+		cfg.Replicas = append(cfg.Replicas, nodeWeight[Node]{Node: node, Weight: c.repsByNode[node]})
+	}
+	switch f := c.nodeKeyFormatter.(type) {
+	case *KetamaNodeKeyFormatter[Node]:
+		format := f.GetFormat()
+		cfg.Format = &format
+	case KetamaNodeKeyFormatter[Node]:
+		format := f.GetFormat()
+		cfg.Format = &format
+	}
+	return json.Marshal(cfg)
+}
+
+// UnmarshalJSON restores the configuration marshaled by MarshalJSON into c, replacing its nodes and weights,
+// and rebuilds the continuum, adding nodes in order so that the placement is deterministic.
+// The hash algorithms of c, and its formatter if no format was marshaled, are kept,
+// as is the metadata of the nodes still in c.
+// A zero HashRing is set up as New does first.
+func (c *HashRing[Node]) UnmarshalJSON(data []byte) error {
+	var cfg hashRingJSON[Node]
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return err
+	}
+	if c.hashAlg == nil {
+		*c = *New[Node]()
+	}
+
+	if c.onChange != nil {
+		defer c.notifyChange(maps.Clone(c.allNodes))
+	}
+
+	c.removeAllNodes()
+	if cfg.NumReps > 0 {
+		c.numReps = cfg.NumReps
+	}
+	c.weightByNode = make(map[Node]int, len(cfg.Weights))
+	for _, w := range cfg.Weights {
+		c.weightByNode[w.Node] = w.Weight
+	}
+	c.isWeighted = cfg.IsWeighted && len(c.weightByNode) > 0
+	c.repsByNode = make(map[Node]int, len(cfg.Replicas))
+	for _, r := range cfg.Replicas {
+		c.repsByNode[r.Node] = r.Weight
+	}
+	if cfg.Format != nil {
+		c.nodeKeyFormatter = NewKetamaNodeKeyFormatter[Node](*cfg.Format)
+	}
+	c.addNodes(c.sortedNodes(cfg.Nodes)...)
+	c.pruneRemoved()
+	return nil
+}
+
+// sortedNodes sorts nodes in place by their keys, as formatted for the first virtual node, and returns them.
+func (c *HashRing[Node]) sortedNodes(nodes []Node) []Node {
+	slices.SortStableFunc(nodes, func(a, b Node) int {
+		return strings.Compare(c.getIterateKeyForNode(a, 0), c.getIterateKeyForNode(b, 0))
+	})
+	return nodes
+}
@@ -0,0 +1,84 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import (
+	"hash/fnv"
+	"iter"
+	"slices"
+)
+
+// JumpHashRing is a consistent hash of names onto an ordered list of nodes, by the jump consistent hash
+// of Lamping and Veach, https://arxiv.org/abs/1406.2294, as an alternative to HashRing for many nodes.
+// It stores no continuum, only the list of nodes, and locates a name in O(log n) time without any lookup table,
+// while the keys are spread evenly, without the variance of virtual nodes.
+//
+// The tradeoff is that nodes are addressed by their index in the list: appending a node moves only
+// the names the new node takes over, and removing the last node only the names it held, as HashRing does,
+// but removing a node in the middle shifts the indexes of all nodes after it, reshuffling many more names
+// than HashRing would. It suits nodes such as shards numbered stably, which are only added or removed at the end.
+// Weights, virtual nodes and nodes of the same key are not supported.
+type JumpHashRing[Node comparable] struct {
+	nodes []Node
+}
+
+// NewJump creates a jump consistent hash of nodes, in order.
+func NewJump[Node comparable](nodes ...Node) *JumpHashRing[Node] {
+	c := &JumpHashRing[Node]{}
+	c.AddNodes(nodes...)
+	return c
+}
+
+// AddNodes appends nodes, skipping those already present, to the list of nodes.
+func (c *JumpHashRing[Node]) AddNodes(nodes ...Node) {
+	for _, node := range nodes {
+		if !slices.Contains(c.nodes, node) {
+			c.nodes = append(c.nodes, node)
+		}
+	}
+}
+
+// RemoveNodes removes nodes from the list of nodes, keeping the order of the others,
+// and returns the number of nodes removed, not counting the nodes not in the list.
+// The nodes after a removed one move to a lower index, and take over the names of other nodes.
+func (c *JumpHashRing[Node]) RemoveNodes(nodes ...Node) int {
+	n := len(c.nodes)
+	c.nodes = slices.DeleteFunc(c.nodes, func(node Node) bool {
+		return slices.Contains(nodes, node)
+	})
+	return n - len(c.nodes)
+}
+
+// Get returns the node name hashes to, or false if there are no nodes.
+func (c *JumpHashRing[Node]) Get(name string) (Node, bool) {
+	if len(c.nodes) == 0 {
+		var zeroN Node
+		return zeroN, false
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return c.nodes[jumpHash(h.Sum64(), len(c.nodes))], true
+}
+
+// All returns an iterator over all nodes, in order.
+func (c *JumpHashRing[Node]) All() iter.Seq[Node] {
+	return slices.Values(c.nodes)
+}
+
+// Len returns the number of nodes.
+func (c *JumpHashRing[Node]) Len() int {
+	return len(c.nodes)
+}
+
+// jumpHash returns the bucket in [0, numBuckets) key hashes to, as the jump consistent hash does.
+func jumpHash(key uint64, numBuckets int) int {
+	var b, j int64 = -1, 0
+	for j < int64(numBuckets) {
+		b = j
+		key = key*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((key>>33)+1)))
+	}
+	return int(b)
+}
@@ -17,11 +17,15 @@ func (c *HashRing[Node]) getIterateKeyForNode(node Node, repetition int) string
 }
 
 func (c *HashRing[Node]) getIterateHashKeyForNode(node Node, repetition int) []uint32 {
+	if c.nodeHashAlg != nil {
+		return c.nodeHashAlg.Hash(c.getIterateKeyForNode(node, repetition))
+	}
 	return c.hashAlg.Hash(c.getIterateKeyForNode(node, repetition))
 }
 
 // 127.0.0.1:11311-0 -> 1122334455
 // IterateKey -> IterateHashKey
+// The key prefix, if any, is prepended to iterateKey.
 func (c *HashRing[Node]) getHashKey(iterateKey string) uint32 {
-	return c.hashAlg.Hash(iterateKey)[0]
+	return c.hashAlg.Hash(c.keyPrefix + iterateKey)[0]
 }
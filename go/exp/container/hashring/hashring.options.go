@@ -0,0 +1,73 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import "fmt"
+
+// WithKeyPrefix prepends prefix to names before hashing them in Get, GetSince and GetN,
+// so that rings sharing the same nodes, such as logical caches, map the same name differently.
+func WithKeyPrefix[Node comparable](prefix string) HashRingOption[Node] {
+	return HashRingOptionFunc[Node](func(c *HashRing[Node]) {
+		c.keyPrefix = prefix
+	})
+}
+
+// WithHashAlgorithm sets the hash algorithm to locate keys and to place nodes by, KetamaHash by default,
+// such as one of the known hashing algorithms, a Hash32Func, or one looked up by LookupHashAlgorithm.
+// Algorithms other than KetamaHash return one hash per key, so nodes are placed by one hash per virtual node,
+// without the 4 virtual nodes per MD5 digest of Ketama.
+// WithHashRingNodeHashAlg, if set, still takes precedence to place nodes.
+func WithHashAlgorithm[Node comparable](alg HashAlgorithm) HashRingOption[Node] {
+	return HashRingOptionFunc[Node](func(c *HashRing[Node]) {
+		c.hashAlg = alg
+	})
+}
+
+// WithBoundedLoad makes GetLeastLoaded skip, in ring order, the nodes whose loads reach
+// ceil(average load * factor), as consistent hashing with bounded loads does,
+// so that no node is overloaded by skewed names.
+// factor must be greater than 1, WithBoundedLoad panics otherwise.
+func WithBoundedLoad[Node comparable](factor float64) HashRingOption[Node] {
+	if !(factor > 1) {
+		panic(fmt.Sprintf("hashring: bounded load factor %v must be greater than 1", factor))
+	}
+	return HashRingOptionFunc[Node](func(c *HashRing[Node]) {
+		c.loadFactor = factor
+	})
+}
+
+// WithOnChange sets f to be called with the nodes added and removed, once AddNodes, SetNodes, SetWeight,
+// RemoveNodes, RemoveAllNodes or UnmarshalJSON changes the nodes of the ring, such as to warm caches
+// as the ownership of names shifts.
+// f is called after the continuum is rebuilt, so that Get in f locates names in the new ring,
+// and is not called by mutations leaving the nodes unchanged, or by weight changes alone.
+// Nodes are reported in no particular order.
+func WithOnChange[Node comparable](f func(added, removed []Node)) HashRingOption[Node] {
+	return HashRingOptionFunc[Node](func(c *HashRing[Node]) {
+		c.onChange = f
+	})
+}
+
+// WithNumReplicas sets the number of virtual nodes per node, 160 by default.
+// More virtual nodes spread names more evenly among nodes, at the cost of memory and of the time to place nodes.
+// Nodes already in the ring, if any, are placed again with n virtual nodes each.
+// n must be greater than 0, WithNumReplicas panics otherwise.
+func WithNumReplicas[Node comparable](n int) HashRingOption[Node] {
+	if n <= 0 {
+		panic(fmt.Sprintf("hashring: number of replicas %d must be greater than 0", n))
+	}
+	return HashRingOptionFunc[Node](func(c *HashRing[Node]) {
+		if c.numReps == n {
+			return
+		}
+		c.numReps = n
+		if len(c.allNodes) == 0 {
+			return
+		}
+		nodes := c.sortedNodes(c.getAllNodes())
+		c.removeAllNodes()
+		c.addNodes(nodes...)
+	})
+}
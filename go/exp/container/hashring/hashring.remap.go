@@ -0,0 +1,99 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package hashring
+
+import (
+	"maps"
+	"slices"
+)
+
+// RemapStats reports how much of hashring a membership change remapped, such as to verify that a change
+// moves few keys, or to monitor churn.
+type RemapStats struct {
+	// Positions is the number of virtual node positions added, removed, or reassigned to another node.
+	Positions int
+	// KeyspaceFraction is the fraction of the 32-bit keyspace located to another node than before, in [0, 1],
+	// that is the expected fraction of names remapped, as far as the hash algorithm spreads names evenly.
+	KeyspaceFraction float64
+}
+
+// AddNodesWithStats inserts nodes into the consistent hash cycle, as AddNodes does,
+// and returns how much of the continuum moved.
+func (c *HashRing[Node]) AddNodesWithStats(nodes ...Node) RemapStats {
+	before := c.continuum()
+	c.AddNodes(nodes...)
+	return before.remapStats(c)
+}
+
+// RemoveNodesWithStats removes nodes from the consistent hash cycle, as RemoveNodes does,
+// and returns the number of nodes removed and how much of the continuum moved.
+func (c *HashRing[Node]) RemoveNodesWithStats(nodes ...Node) (int, RemapStats) {
+	before := c.continuum()
+	removed := c.RemoveNodes(nodes...)
+	return removed, before.remapStats(c)
+}
+
+// continuum returns a copy of the positions of c, enough to locate hashes as c does.
+func (c *HashRing[Node]) continuum() *HashRing[Node] {
+	return &HashRing[Node]{
+		sortedKeys: slices.Clone(c.sortedKeys),
+		nodeByKey:  maps.Clone(c.nodeByKey),
+		nearest:    c.nearest,
+	}
+}
+
+// remapStats returns how much of the continuum moved from c to after.
+func (c *HashRing[Node]) remapStats(after *HashRing[Node]) RemapStats {
+	var stats RemapStats
+	for k, n := range c.nodeByKey {
+		if m, has := after.nodeByKey[k]; !has || m != n {
+			stats.Positions++
+		}
+	}
+	for k := range after.nodeByKey {
+		if _, has := c.nodeByKey[k]; !has {
+			stats.Positions++
+		}
+	}
+
+	// The owner of a hash is constant between consecutive breakpoints, in (prev, next],
+	// so comparing the owners at each breakpoint covers the whole keyspace.
+	bounds := append(c.breakpoints(), after.breakpoints()...)
+	if len(bounds) == 0 {
+		return stats
+	}
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+	var moved uint64
+	for i, b := range bounds {
+		n1, ok1 := c.getNodeByHashKey(b)
+		n2, ok2 := after.getNodeByHashKey(b)
+		if ok1 == ok2 && n1 == n2 {
+			continue
+		}
+		if len(bounds) == 1 {
+			moved = 1 << 32
+			break
+		}
+		// the arc from the breakpoint before, wrapping around the continuum, as uint32 does
+		moved += uint64(b - bounds[(i+len(bounds)-1)%len(bounds)])
+	}
+	stats.KeyspaceFraction = float64(moved) / (1 << 32)
+	return stats
+}
+
+// breakpoints returns the hashes ending the arcs of the continuum owned by a single node each,
+// that is the positions of virtual nodes, and the midpoints between them if nearest is set.
+func (c *HashRing[Node]) breakpoints() []uint32 {
+	bounds := slices.Clone(c.sortedKeys)
+	if !c.nearest {
+		return bounds
+	}
+	for i, next := range c.sortedKeys {
+		prev := c.sortedKeys[(i+len(c.sortedKeys)-1)%len(c.sortedKeys)]
+		bounds = append(bounds, prev+(next-prev)/2)
+	}
+	return bounds
+}
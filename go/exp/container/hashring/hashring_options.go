@@ -134,6 +134,22 @@ func WithHashRingHashAlg[Node comparable](v HashAlgorithm) HashRingOption[Node]
 	})
 }
 
+// WithHashRingNodeHashAlg sets nodeHashAlg in HashRing[Node].
+// The hash algorithm to use when placing virtual nodes in the continuum, hashAlg is used if nil
+func WithHashRingNodeHashAlg[Node comparable](v HashAlgorithm) HashRingOption[Node] {
+	return HashRingOptionFunc[Node](func(o *HashRing[Node]) {
+		o.nodeHashAlg = v
+	})
+}
+
+// WithHashRingNearest sets nearest in HashRing[Node].
+// choose the closer one of the two virtual nodes around a key, instead of the next one clockwise
+func WithHashRingNearest[Node comparable](v bool) HashRingOption[Node] {
+	return HashRingOptionFunc[Node](func(o *HashRing[Node]) {
+		o.nearest = v
+	})
+}
+
 // WithHashRingWeightByNode appends weightByNode in HashRing[Node].
 // node weights for ketama, a map from InetSocketAddress to weight as Integer
 func WithHashRingWeightByNode[Node comparable](m map[Node]int) HashRingOption[Node] {
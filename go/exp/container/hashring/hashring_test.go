@@ -5,9 +5,17 @@
 package hashring
 
 import (
+	"encoding/json"
+	"fmt"
+	"hash/crc32"
+	"maps"
+	"math"
 	"runtime"
 	"slices"
 	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"testing/quick"
 
@@ -697,6 +705,21 @@ func BenchmarkGetTwoLarge(b *testing.B) {
 	}
 }
 
+func BenchmarkSetNodesLarge(b *testing.B) {
+	x := New[string]()
+	nodes := make([]string, 2000)
+	for i := range nodes {
+		nodes[i] = "start" + strconv.Itoa(i)
+	}
+	x.SetNodes(nodes...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// swap one node per call
+		nodes[i%len(nodes)] = "foo" + strconv.Itoa(i)
+		x.SetNodes(nodes...)
+	}
+}
+
 // from @edsrzf on github:
 func TestAddCollision(t *testing.T) {
 	// These two strings produce several crc32 collisions after "|i" is
@@ -728,3 +751,1116 @@ func TestAddCollision(t *testing.T) {
 func getN[Node comparable](x *HashRing[Node], name string, n int) []Node {
 	return slices.Collect(iter_.FilterN(x.GetSince(name), n))
 }
+
+func TestHAProxyCompat(t *testing.T) {
+	if got, want := haproxyFullHash(4096), uint32(1237450891); got != want {
+		t.Errorf("full_hash(4096) = %d, want %d", got, want)
+	}
+	if got, want := haproxyFullHash(1), uint32(881230262); got != want {
+		t.Errorf("full_hash(1) = %d, want %d", got, want)
+	}
+
+	// servers "s1", "s2" and "s3" in a HAProxy backend, with ids 1, 2 and 3
+	// and weight 1, by "balance uri" and "hash-type consistent", for example.
+	puid := func(node string) int {
+		n, _ := strconv.Atoi(node[1:])
+		return n
+	}
+	x := New[string](WithHAProxyCompat[string](puid))
+	x.AddNodes("s1", "s2", "s3")
+	if got, want := len(x.sortedKeys), 3*16; got != want {
+		t.Errorf("got %d virtual nodes, want %d", got, want)
+	}
+
+	// vectors computed by hash_sdbm and chash_get_server_hash of HAProxy
+	tests := []struct {
+		key  string
+		hash uint32
+		want string
+	}{
+		{"Alice", 335258752, "s3"},
+		{"Bob", 552540917, "s1"},
+		{"Eve", 577784628, "s3"},
+		{"Carol", 2693003473, "s1"},
+		{"Dave", 2349428748, "s2"},
+		{"/index.html", 858007926, "s2"},
+		{"/api/v1/users", 1139031800, "s3"},
+		{"10.0.0.1", 2328198470, "s2"},
+		{"café", 393933774, "s2"},
+	}
+	for _, tt := range tests {
+		if got := x.getHashKey(tt.key); got != tt.hash {
+			t.Errorf("sdbm(%q) = %d, want %d", tt.key, got, tt.hash)
+		}
+		got, has := x.Get(tt.key)
+		if !has || got != tt.want {
+			t.Errorf("Get(%q) = %q, %t, want %q", tt.key, got, has, tt.want)
+		}
+	}
+}
+
+func TestKeyPrefix(t *testing.T) {
+	nodes := []string{"abcdefg", "hijklmn", "opqrstu", "vwxyz"}
+	plain := New[string]()
+	plain.AddNodes(nodes...)
+	users := New[string](WithKeyPrefix[string]("users:"))
+	users.AddNodes(nodes...)
+	orders := New[string](WithKeyPrefix[string]("orders:"))
+	orders.AddNodes(nodes...)
+
+	var differ int
+	for i := 0; i < 100; i++ {
+		name := "key" + strconv.Itoa(i)
+		u, ok := users.Get(name)
+		if !ok {
+			t.Fatalf("Get(%q): got not found", name)
+		}
+		if want, _ := plain.Get("users:" + name); u != want {
+			t.Errorf("Get(%q) with prefix %q: got %q, want %q", name, "users:", u, want)
+		}
+		got := slices.Collect(users.GetSince(name))
+		want := slices.Collect(plain.GetSince("users:" + name))
+		if !slices.Equal(got, want) {
+			t.Errorf("GetSince(%q) with prefix %q: got %v, want %v", name, "users:", got, want)
+		}
+
+		if o, _ := orders.Get(name); o != u {
+			differ++
+		}
+	}
+	if differ == 0 {
+		t.Errorf("expected the same names to map to different nodes under different prefixes")
+	}
+}
+
+func TestWeighted(t *testing.T) {
+	weights := map[string]int{"abcdefg": 1, "hijklmn": 2, "opqrstu": 4}
+	x := New[string](WithHashRingIsWeighted[string](true), WithHashRingWeightByNode[string](weights))
+	x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+
+	var totalWeight int
+	for _, w := range weights {
+		totalWeight += w
+	}
+	points := make(map[string]int)
+	for _, node := range x.nodeByKey {
+		points[node]++
+	}
+	for node, w := range weights {
+		if want := w * x.numReps * len(weights) / totalWeight; points[node] != want {
+			t.Errorf("%s of weight %d: got %d virtual nodes, want %d", node, w, points[node], want)
+		}
+	}
+
+	const numKeys = 100000
+	primaries := make(map[string]int)
+	for i := 0; i < numKeys; i++ {
+		name := "key" + strconv.Itoa(i)
+		node, ok := x.Get(name)
+		if !ok {
+			t.Fatalf("Get(%q): got not found", name)
+		}
+		primaries[node]++
+		if i%100 == 0 {
+			if nodes := getN(x, name, 3); len(nodes) != 3 || nodes[0] != node {
+				t.Errorf("GetSince(%q): got %v, want 3 distinct nodes led by %q", name, nodes, node)
+			}
+		}
+	}
+	for node, w := range weights {
+		want := float64(numKeys) * float64(w) / float64(totalWeight)
+		if got := float64(primaries[node]); got < want*0.8 || got > want*1.2 {
+			t.Errorf("%s of weight %d: got primary for %d keys, want about %.0f", node, w, primaries[node], want)
+		}
+	}
+}
+
+func TestGetNEmpty(t *testing.T) {
+	x := New[string]()
+	if nodes := x.GetN("abcdefg", 3); len(nodes) != 0 {
+		t.Errorf("GetN on an empty ring: got %v, want none", nodes)
+	}
+	x.AddNodes("abcdefg", "hijklmn")
+	for _, n := range []int{0, -1} {
+		if nodes := x.GetN("abcdefg", n); len(nodes) != 0 {
+			t.Errorf("GetN(%d): got %v, want none", n, nodes)
+		}
+	}
+}
+
+func TestGetNQuickSince(t *testing.T) {
+	x := New[string]()
+	x.AddNodes("abcdefg", "hijklmn", "opqrstu", "vwxyz")
+	f := func(s string, n uint8) bool {
+		got := x.GetN(s, int(n%6))
+		want := getN(x, s, int(n%6))
+		if len(want) == 0 {
+			return len(got) == 0
+		}
+		if first, _ := x.Get(s); got[0] != first {
+			return false
+		}
+		return slices.Equal(got, want)
+	}
+	if err := quick.Check(f, nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestRemapStats(t *testing.T) {
+	nodes := []string{"abcdefg", "hijklmn", "opqrstu", "vwxyz"}
+	var names []string
+	for i := range 100000 {
+		names = append(names, "name-"+strconv.Itoa(i))
+	}
+	for _, nearest := range []bool{false, true} {
+		x := New[string](WithHashRingNearest[string](nearest))
+		stats := x.AddNodesWithStats(nodes[:3]...)
+		if stats.Positions != 3*defaultNumReps || stats.KeyspaceFraction != 1 {
+			t.Errorf("AddNodesWithStats(%v) to empty: got %+v, want %d positions and the whole keyspace",
+				nodes[:3], stats, 3*defaultNumReps)
+		}
+
+		before := make(map[string]string)
+		for _, name := range names {
+			before[name], _ = x.Get(name)
+		}
+		stats = x.AddNodesWithStats(nodes[3])
+		if stats.Positions != defaultNumReps {
+			t.Errorf("AddNodesWithStats(%q): got %d positions, want %d", nodes[3], stats.Positions, defaultNumReps)
+		}
+		var moved int
+		for _, name := range names {
+			if n, _ := x.Get(name); n != before[name] {
+				if n != nodes[3] {
+					t.Errorf("Get(%q) = %q, want %q or %q", name, n, before[name], nodes[3])
+				}
+				moved++
+			}
+		}
+		if got, want := stats.KeyspaceFraction, float64(moved)/float64(len(names)); math.Abs(got-want) > 0.01 {
+			t.Errorf("AddNodesWithStats(%q): got a keyspace fraction of %v, want about %v", nodes[3], got, want)
+		}
+
+		removed, stats2 := x.RemoveNodesWithStats(nodes[3], "missing")
+		if removed != 1 || stats2 != stats {
+			t.Errorf("RemoveNodesWithStats(%q): got %d, %+v, want 1, %+v", nodes[3], removed, stats2, stats)
+		}
+		if removed, stats = x.RemoveNodesWithStats("missing"); removed != 0 || stats != (RemapStats{}) {
+			t.Errorf("RemoveNodesWithStats(%q): got %d, %+v, want nothing moved", "missing", removed, stats)
+		}
+	}
+}
+
+func TestHashAlgorithm(t *testing.T) {
+	numReps := 160
+	nodes := []string{"abcdefg", "hijklmn", "opqrstu"}
+	crc := New[string](WithHashRingNumReps[string](numReps), WithHashAlgorithm[string](Hash32Func(crc32.ChecksumIEEE)))
+	crc.AddNodes(nodes...)
+	// one virtual node per hash, instead of 4 per MD5 digest
+	if got, want := len(crc.nodeByKey), len(nodes)*numReps; got > want || got < want-len(nodes) {
+		t.Errorf("got %d virtual nodes, want %d", got, want)
+	}
+
+	if LookupHashAlgorithm("crc32-custom") != nil {
+		t.Fatalf("LookupHashAlgorithm: got an algorithm not registered")
+	}
+	RegisterHashAlgorithm("crc32-custom", Hash32Func(crc32.ChecksumIEEE))
+	if !slices.Contains(HashAlgorithms(), "crc32-custom") || !slices.Contains(HashAlgorithms(), "ketama") {
+		t.Errorf("HashAlgorithms(): got %v, want crc32-custom and ketama listed", HashAlgorithms())
+	}
+	registered := New[string](WithHashRingNumReps[string](numReps), WithHashAlgorithm[string](LookupHashAlgorithm("crc32-custom")))
+	registered.AddNodes(nodes...)
+	ketama := New[string](WithHashAlgorithm[string](LookupHashAlgorithm("ketama")))
+	ketama.AddNodes(nodes...)
+	def := New[string]()
+	def.AddNodes(nodes...)
+	for i := 0; i < 100; i++ {
+		name := "key" + strconv.Itoa(i)
+		if got, _ := registered.Get(name); got != crc.getNodeByHashKeyIndex(crc.searchHashKeyIndex(crc32.ChecksumIEEE([]byte(name)))) {
+			t.Errorf("Get(%q) by registered crc32: got %q", name, got)
+		}
+		if got, _ := ketama.Get(name); got != def.getNodeByHashKeyIndex(def.searchHashKeyIndex(def.getHashKey(name))) {
+			t.Errorf("Get(%q) by registered ketama: got %q, want the default", name, got)
+		}
+	}
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Errorf("RegisterHashAlgorithm twice: got no panic")
+			}
+		}()
+		RegisterHashAlgorithm("crc32-custom", CRCHash)
+	}()
+}
+
+func TestSetWeight(t *testing.T) {
+	points := func(x *HashRing[string]) map[string]int {
+		points := make(map[string]int)
+		for _, node := range x.nodeByKey {
+			points[node]++
+		}
+		return points
+	}
+
+	x := New[string]()
+	x.AddNodes("abcdefg", "hijklmn")
+	x.SetWeight("opqrstu", 2)
+	if !x.isWeighted {
+		t.Fatalf("SetWeight on a ring not weighted: got not weighted, want weighted")
+	}
+	got := points(x)
+	for node, want := range map[string]int{"abcdefg": 120, "hijklmn": 120, "opqrstu": 240} {
+		if got[node] != want {
+			t.Errorf("%s: got %d virtual nodes, want %d", node, got[node], want)
+		}
+	}
+
+	x.SetWeight("abcdefg", 0)
+	if _, has := x.allNodes["abcdefg"]; !has {
+		t.Errorf("SetWeight(%q, 0): got node removed, want kept", "abcdefg")
+	}
+	got = points(x)
+	for node, want := range map[string]int{"abcdefg": 0, "hijklmn": 160, "opqrstu": 320} {
+		if got[node] != want {
+			t.Errorf("%s: got %d virtual nodes, want %d", node, got[node], want)
+		}
+	}
+	for i := 0; i < 1000; i++ {
+		if node, _ := x.Get("key" + strconv.Itoa(i)); node == "abcdefg" {
+			t.Fatalf("Get(%q): got %q of weight 0", "key"+strconv.Itoa(i), node)
+		}
+	}
+}
+
+func TestLenContainsNode(t *testing.T) {
+	x := New[string]()
+	if x.Len() != 0 || x.ContainsNode("abcdefg") {
+		t.Errorf("empty ring: got Len %d, want 0 and no node", x.Len())
+	}
+	x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+	if x.Len() != 3 {
+		t.Errorf("Len: got %d, want %d", x.Len(), 3)
+	}
+	for _, tt := range []struct {
+		node string
+		want bool
+	}{{"abcdefg", true}, {"opqrstu", true}, {"vwxyz", false}} {
+		if got := x.ContainsNode(tt.node); got != tt.want {
+			t.Errorf("ContainsNode(%q): got %t, want %t", tt.node, got, tt.want)
+		}
+	}
+	x.RemoveNodes("hijklmn")
+	if x.Len() != 2 || x.ContainsNode("hijklmn") {
+		t.Errorf("RemoveNodes(%q): got Len %d, want 2 and node removed", "hijklmn", x.Len())
+	}
+}
+
+func TestJSON(t *testing.T) {
+	for _, weighted := range []bool{false, true} {
+		weights := map[string]int{"abcdefg": 1, "hijklmn": 2, "opqrstu": 4}
+		x := New[string](WithHashRingIsWeighted[string](weighted), WithHashRingWeightByNode[string](weights),
+			WithHashRingNumReps[string](100),
+			WithHashRingNodeKeyFormatter[string](NewKetamaNodeKeyFormatter[string](LibMemcached)))
+		x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+
+		data, err := json.Marshal(x)
+		if err != nil {
+			t.Fatalf("json.Marshal: %v", err)
+		}
+		var y HashRing[string]
+		if err := json.Unmarshal(data, &y); err != nil {
+			t.Fatalf("json.Unmarshal(%s): %v", data, err)
+		}
+		if y.numReps != 100 || y.isWeighted != weighted || y.Len() != 3 {
+			t.Errorf("json.Unmarshal(%s): got numReps %d, weighted %t, %d nodes, want 100, %t, 3",
+				data, y.numReps, y.isWeighted, y.Len(), weighted)
+		}
+		if f, ok := y.nodeKeyFormatter.(*KetamaNodeKeyFormatter[string]); !ok || f.GetFormat() != LibMemcached {
+			t.Errorf("json.Unmarshal(%s): got formatter %#v, want LibMemcached", data, y.nodeKeyFormatter)
+		}
+		if !slices.Equal(x.sortedKeys, y.sortedKeys) || !maps.Equal(x.nodeByKey, y.nodeByKey) {
+			t.Errorf("json.Unmarshal(%s): got continuum differing from the marshaled one", data)
+		}
+		for i := 0; i < 1000; i++ {
+			name := "key" + strconv.Itoa(i)
+			want, _ := x.Get(name)
+			if got, _ := y.Get(name); got != want {
+				t.Fatalf("Get(%q): got %q, want %q", name, got, want)
+			}
+		}
+	}
+}
+
+func TestBoundedLoad(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("WithBoundedLoad(1): got no panic, want a panic")
+		}
+	}()
+
+	const factor = 1.25
+	nodes := []string{"abcdefg", "hijklmn", "opqrstu", "vwxyz"}
+	x := New[string](WithBoundedLoad[string](factor))
+	x.AddNodes(nodes...)
+
+	// all names hash to the same node
+	const numKeys = 100
+	loads := make(map[string]int)
+	for i := 0; i < numKeys; i++ {
+		node, ok := x.GetLeastLoaded("hot")
+		if !ok {
+			t.Fatalf("GetLeastLoaded(%q): got not found", "hot")
+		}
+		loads[node]++
+		if limit := int(math.Ceil(float64(i+1) / float64(len(nodes)) * factor)); loads[node] > limit {
+			t.Fatalf("GetLeastLoaded(%q) #%d: got %s of load %d, want at most %d", "hot", i, node, loads[node], limit)
+		}
+	}
+	if len(loads) != len(nodes) {
+		t.Errorf("GetLeastLoaded(%q): got loads %v, want spread over %d nodes", "hot", loads, len(nodes))
+	}
+
+	primary, _ := x.Get("hot")
+	for node, load := range loads {
+		for range load {
+			x.Done(node)
+		}
+	}
+	if x.totalLoad != 0 || len(x.loadByNode) != 0 {
+		t.Errorf("Done: got total load %d, loads %v, want none", x.totalLoad, x.loadByNode)
+	}
+	if node, _ := x.GetLeastLoaded("hot"); node != primary {
+		t.Errorf("GetLeastLoaded(%q) with no load: got %s, want %s", "hot", node, primary)
+	}
+
+	WithBoundedLoad[string](1)
+}
+
+func TestGetBytes(t *testing.T) {
+	type bytesKey []byte
+	type stringKey string
+	for _, prefix := range []string{"", "cache:"} {
+		x := New[string](WithKeyPrefix[string](prefix))
+		x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+		for i := 0; i < 1000; i++ {
+			name := "key" + strconv.Itoa(i)
+			want, _ := x.Get(name)
+			if got, ok := x.GetBytes([]byte(name)); !ok || got != want {
+				t.Fatalf("GetBytes(%q): got %q, want %q", name, got, want)
+			}
+			if got, ok := GetKey(x, bytesKey(name)); !ok || got != want {
+				t.Fatalf("GetKey(bytesKey(%q)): got %q, want %q", name, got, want)
+			}
+			if got, ok := GetKey(x, stringKey(name)); !ok || got != want {
+				t.Fatalf("GetKey(stringKey(%q)): got %q, want %q", name, got, want)
+			}
+		}
+	}
+
+	if _, ok := New[string]().GetBytes([]byte("key")); ok {
+		t.Errorf("GetBytes on an empty ring: got found, want not found")
+	}
+
+	x := New[string]()
+	x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+	name := []byte("a name longer than the 32 bytes buffered on the stack")
+	get := testing.AllocsPerRun(100, func() { x.Get(string(name)) })
+	getBytes := testing.AllocsPerRun(100, func() { x.GetBytes(name) })
+	if getBytes >= get {
+		t.Errorf("GetBytes: got %v allocs, want fewer than the %v allocs of Get(string(name))", getBytes, get)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	x := NewConcurrent[string]()
+	x.AddNodes("abcdefg", "hijklmn")
+	snapshot := x.Snapshot()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				name := "key" + strconv.Itoa(j)
+				if node, ok := x.Get(name); !ok || (node != "abcdefg" && node != "hijklmn" && node != "opqrstu") {
+					t.Errorf("Get(%q): got %q, %t, want a node", name, node, ok)
+					return
+				}
+				if nodes := x.GetN(name, 2); len(nodes) != 2 {
+					t.Errorf("GetN(%q, 2): got %v, want 2 nodes", name, nodes)
+					return
+				}
+			}
+		}()
+	}
+	for j := 0; j < 20; j++ {
+		x.AddNodes("opqrstu")
+		x.RemoveNodes("opqrstu")
+	}
+	wg.Wait()
+
+	if snapshot.Len() != 2 || snapshot.ContainsNode("opqrstu") {
+		t.Errorf("Snapshot: got %d nodes, want the 2 nodes before the mutations", snapshot.Len())
+	}
+	x.SetWeight("opqrstu", 2)
+	if !x.ContainsNode("opqrstu") || x.Len() != 3 {
+		t.Errorf("SetWeight(%q, 2): got %d nodes, want 3", "opqrstu", x.Len())
+	}
+	x.RemoveAllNodes()
+	if _, ok := x.Get("key"); ok {
+		t.Errorf("RemoveAllNodes: got found, want not found")
+	}
+}
+
+// rwMutexHashRing guards a HashRing by a sync.RWMutex, as compared to ConcurrentHashRing.
+type rwMutexHashRing struct {
+	mu   sync.RWMutex
+	ring *HashRing[string]
+}
+
+func (c *rwMutexHashRing) Get(name string) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ring.Get(name)
+}
+
+func (c *rwMutexHashRing) AddNodes(nodes ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ring.AddNodes(nodes...)
+}
+
+// BenchmarkConcurrentGet compares lookups concurrent with rare mutations, run it with -race to check them too.
+func BenchmarkConcurrentGet(b *testing.B) {
+	nodes := []string{"start0", "start1", "start2", "start3", "start4"}
+	b.Run("atomic", func(b *testing.B) {
+		x := NewConcurrent[string]()
+		x.AddNodes(nodes...)
+		benchmarkConcurrentGet(b, x)
+	})
+	b.Run("rwmutex", func(b *testing.B) {
+		x := &rwMutexHashRing{ring: New[string]()}
+		x.AddNodes(nodes...)
+		benchmarkConcurrentGet(b, x)
+	})
+}
+
+func benchmarkConcurrentGet(b *testing.B, x interface {
+	Get(name string) (string, bool)
+	AddNodes(nodes ...string)
+}) {
+	var n atomic.Int64
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if i := n.Add(1); i%10000 == 0 {
+				x.AddNodes("start" + strconv.FormatInt(i%7, 10))
+			}
+			x.Get("nothing")
+		}
+	})
+}
+
+func TestRemoveNodesCount(t *testing.T) {
+	for _, weighted := range []bool{false, true} {
+		x := New[string](WithHashRingIsWeighted[string](weighted),
+			WithHashRingWeightByNode[string](map[string]int{"abcdefg": 1, "hijklmn": 1, "opqrstu": 1}))
+		x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+		sortedKeys := slices.Clone(x.sortedKeys)
+
+		if n := x.RemoveNodes("vwxyz"); n != 0 {
+			t.Errorf("RemoveNodes(%q): got %d, want 0", "vwxyz", n)
+		}
+		if !slices.Equal(x.sortedKeys, sortedKeys) {
+			t.Errorf("RemoveNodes(%q): got the cycle changed, want untouched", "vwxyz")
+		}
+		if n := x.RemoveNodes("abcdefg", "abcdefg", "vwxyz", "hijklmn"); n != 2 {
+			t.Errorf("RemoveNodes(%q, %q, %q, %q): got %d, want 2", "abcdefg", "abcdefg", "vwxyz", "hijklmn", n)
+		}
+		if x.Len() != 1 || !x.ContainsNode("opqrstu") {
+			t.Errorf("RemoveNodes: got %d nodes, want only %q", x.Len(), "opqrstu")
+		}
+		if n := x.RemoveNodes("abcdefg"); n != 0 {
+			t.Errorf("RemoveNodes(%q) twice: got %d, want 0", "abcdefg", n)
+		}
+	}
+}
+
+func TestReplicas(t *testing.T) {
+	x := New[string]()
+	if got := x.Replicas("abcdefg"); got != nil {
+		t.Errorf("Replicas on an empty ring: got %v, want nil", got)
+	}
+	x.AddNodes("abcdefg", "hijklmn")
+	var total int
+	for _, node := range []string{"abcdefg", "hijklmn"} {
+		positions := x.Replicas(node)
+		if len(positions) != x.numReps {
+			t.Errorf("Replicas(%q): got %d positions, want %d", node, len(positions), x.numReps)
+		}
+		if !slices.IsSorted(positions) {
+			t.Errorf("Replicas(%q): got positions not sorted", node)
+		}
+		for _, pos := range positions {
+			if x.nodeByKey[pos] != node {
+				t.Fatalf("Replicas(%q): got position %d of %q", node, pos, x.nodeByKey[pos])
+			}
+			if got, _ := x.getNodeByHashKey(pos); got != node {
+				t.Fatalf("Replicas(%q): got position %d located to %q", node, pos, got)
+			}
+		}
+		total += len(positions)
+	}
+	if total != len(x.sortedKeys) {
+		t.Errorf("Replicas: got %d positions in all, want %d", total, len(x.sortedKeys))
+	}
+	if got := x.Replicas("opqrstu"); got != nil {
+		t.Errorf("Replicas(%q) not in the ring: got %v, want nil", "opqrstu", got)
+	}
+}
+
+func TestClone(t *testing.T) {
+	x := New[string](WithHashRingIsWeighted[string](true),
+		WithHashRingWeightByNode[string](map[string]int{"abcdefg": 1, "hijklmn": 2}), WithKeyPrefix[string]("cache:"))
+	x.AddNodes("abcdefg", "hijklmn")
+	y := x.Clone()
+
+	if !slices.Equal(x.sortedKeys, y.sortedKeys) || !maps.Equal(x.nodeByKey, y.nodeByKey) ||
+		!maps.Equal(x.weightByNode, y.weightByNode) || y.keyPrefix != x.keyPrefix || !y.isWeighted {
+		t.Fatalf("Clone: got a ring differing from the original")
+	}
+	for i := 0; i < 100; i++ {
+		name := "key" + strconv.Itoa(i)
+		want, _ := x.Get(name)
+		if got, _ := y.Get(name); got != want {
+			t.Fatalf("Clone: Get(%q): got %q, want %q", name, got, want)
+		}
+	}
+
+	sortedKeys := slices.Clone(x.sortedKeys)
+	nodeByKey := maps.Clone(x.nodeByKey)
+	y.SetWeight("opqrstu", 4)
+	y.RemoveNodes("abcdefg")
+	if x.Len() != 2 || x.ContainsNode("opqrstu") || !x.ContainsNode("abcdefg") {
+		t.Errorf("mutating the clone: got the nodes of the original changed")
+	}
+	if _, has := x.weightByNode["opqrstu"]; has {
+		t.Errorf("mutating the clone: got the weights of the original changed")
+	}
+	if !slices.Equal(x.sortedKeys, sortedKeys) || !maps.Equal(x.nodeByKey, nodeByKey) {
+		t.Errorf("mutating the clone: got the continuum of the original changed")
+	}
+	if y.Len() != 2 || !y.ContainsNode("opqrstu") || y.ContainsNode("abcdefg") {
+		t.Errorf("mutating the clone: got %d nodes, want %q and %q", y.Len(), "hijklmn", "opqrstu")
+	}
+}
+
+func TestOnChange(t *testing.T) {
+	type change struct{ added, removed []string }
+	var changes []change
+	var x *HashRing[string]
+	x = New(WithOnChange(func(added, removed []string) {
+		slices.Sort(added)
+		slices.Sort(removed)
+		changes = append(changes, change{added, removed})
+		for _, node := range added {
+			if !x.ContainsNode(node) {
+				t.Errorf("ContainsNode(%q) in callback: got false, want true", node)
+			}
+		}
+		if node, _ := x.Get("key"); slices.Contains(removed, node) {
+			t.Errorf("Get(%q) in callback: got removed node %q", "key", node)
+		}
+	}))
+	check := func(op string, wantAdded, wantRemoved []string) {
+		t.Helper()
+		if len(changes) != 1 {
+			t.Fatalf("%s: got %d changes, want 1", op, len(changes))
+		}
+		if c := changes[0]; !slices.Equal(c.added, wantAdded) || !slices.Equal(c.removed, wantRemoved) {
+			t.Errorf("%s: got added %v, removed %v, want added %v, removed %v", op, c.added, c.removed, wantAdded, wantRemoved)
+		}
+		changes = nil
+	}
+
+	x.AddNodes("abcdefg", "hijklmn")
+	check("AddNodes", []string{"abcdefg", "hijklmn"}, nil)
+	x.SetNodes("hijklmn", "opqrstu")
+	check("SetNodes", []string{"opqrstu"}, []string{"abcdefg"})
+	x.RemoveNodes("hijklmn", "nonexistent")
+	check("RemoveNodes", nil, []string{"hijklmn"})
+
+	x.AddNodes("opqrstu")
+	x.RemoveNodes("nonexistent")
+	x.SetWeight("opqrstu", 2)
+	if len(changes) != 0 {
+		t.Errorf("mutations leaving the nodes unchanged: got changes %v, want none", changes)
+	}
+	x.SetWeight("abcdefg", 1)
+	check("SetWeight", []string{"abcdefg"}, nil)
+	x.RemoveAllNodes()
+	check("RemoveAllNodes", nil, []string{"abcdefg", "opqrstu"})
+
+	c := NewConcurrent(WithOnChange(func(added, removed []string) {
+		changes = append(changes, change{added, removed})
+	}))
+	c.AddNodes("abcdefg")
+	check("ConcurrentHashRing.AddNodes", []string{"abcdefg"}, nil)
+}
+
+func TestJump(t *testing.T) {
+	x := NewJump[string]()
+	if _, ok := x.Get("key"); ok {
+		t.Fatalf("Get on an empty ring: got ok, want not ok")
+	}
+	x.AddNodes("abcdefg", "hijklmn", "opqrstu", "abcdefg")
+	if got := slices.Collect(x.All()); !slices.Equal(got, []string{"abcdefg", "hijklmn", "opqrstu"}) {
+		t.Fatalf("All: got %v, want the nodes deduplicated in order", got)
+	}
+
+	const keys = 10000
+	owners := make(map[string]string, keys)
+	counts := make(map[string]int)
+	for i := 0; i < keys; i++ {
+		key := "key" + strconv.Itoa(i)
+		node, _ := x.Get(key)
+		owners[key] = node
+		counts[node]++
+	}
+	for node, n := range counts {
+		if n < keys/3*9/10 || n > keys/3*11/10 {
+			t.Errorf("%s: got %d keys, want about %d", node, n, keys/3)
+		}
+	}
+
+	// appending a node only moves keys onto it
+	x.AddNodes("vwxyz")
+	var moved int
+	for key, owner := range owners {
+		if node, _ := x.Get(key); node != owner {
+			moved++
+			if node != "vwxyz" {
+				t.Fatalf("Get(%q) after AddNodes: got %q, want %q or the new node", key, node, owner)
+			}
+		}
+	}
+	if moved < keys/4*9/10 || moved > keys/4*11/10 {
+		t.Errorf("AddNodes: got %d keys moved, want about %d", moved, keys/4)
+	}
+
+	// removing the last node moves its keys back
+	if n := x.RemoveNodes("vwxyz", "nonexistent"); n != 1 {
+		t.Errorf("RemoveNodes: got %d removed, want 1", n)
+	}
+	for key, owner := range owners {
+		if node, _ := x.Get(key); node != owner {
+			t.Fatalf("Get(%q) after RemoveNodes: got %q, want %q", key, node, owner)
+		}
+	}
+	if got := x.Len(); got != 3 {
+		t.Errorf("Len: got %d, want 3", got)
+	}
+}
+
+func TestGetTwoMethod(t *testing.T) {
+	x := New[string]()
+	if _, _, ok, _ := x.GetTwo("key"); ok {
+		t.Fatalf("GetTwo on an empty ring: got ok, want not ok")
+	}
+	x.AddNodes("abcdefg")
+	if primary, secondary, ok, hasSecondary := x.GetTwo("key"); !ok || hasSecondary || primary != "abcdefg" || secondary != primary {
+		t.Fatalf("GetTwo with one node: got %q, %q, %t, %t, want %q, %q, true, false",
+			primary, secondary, ok, hasSecondary, "abcdefg", "abcdefg")
+	}
+
+	x.AddNodes("hijklmn", "opqrstu")
+	for i := 0; i < 1000; i++ {
+		key := "key" + strconv.Itoa(i)
+		primary, secondary, ok, hasSecondary := x.GetTwo(key)
+		want := getN(x, key, 2)
+		if !ok || !hasSecondary || primary != want[0] || secondary != want[1] {
+			t.Fatalf("GetTwo(%q): got %q, %q, %t, %t, want %q, %q, true, true",
+				key, primary, secondary, ok, hasSecondary, want[0], want[1])
+		}
+	}
+	// hashing the name may allocate, as for Get, but collecting the nodes does not
+	want := testing.AllocsPerRun(100, func() { x.Get("key") })
+	if allocs := testing.AllocsPerRun(100, func() { x.GetTwo("key") }); allocs > want {
+		t.Errorf("GetTwo: got %v allocs, want %v as Get", allocs, want)
+	}
+}
+
+func TestNumReplicas(t *testing.T) {
+	x := New(WithNumReplicas[string](512))
+	x.AddNodes("abcdefg", "hijklmn")
+	if got := len(x.Replicas("abcdefg")); got != 512 {
+		t.Errorf("Replicas: got %d virtual nodes, want 512", got)
+	}
+
+	x.ApplyOptions(WithNumReplicas[string](40))
+	for _, node := range []string{"abcdefg", "hijklmn"} {
+		if got := len(x.Replicas(node)); got != 40 {
+			t.Errorf("Replicas(%q) after WithNumReplicas(40): got %d virtual nodes, want 40", node, got)
+		}
+	}
+	if got := len(x.sortedKeys); got != 80 {
+		t.Errorf("after WithNumReplicas(40): got %d virtual nodes in all, want 80", got)
+	}
+	x.RemoveNodes("abcdefg")
+	if got := len(x.sortedKeys); got != 40 {
+		t.Errorf("RemoveNodes after WithNumReplicas(40): got %d virtual nodes left, want 40", got)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("WithNumReplicas(0): got no panic")
+		}
+	}()
+	WithNumReplicas[string](0)
+}
+
+func TestDistribution(t *testing.T) {
+	x := New[string]()
+	keys := make([]string, 10000)
+	for i := range keys {
+		keys[i] = "key" + strconv.Itoa(i)
+	}
+	if got := x.Distribution(keys); len(got) != 0 {
+		t.Errorf("Distribution on an empty ring: got %v, want empty", got)
+	}
+	if got := x.LoadStdDev(keys); got != 0 {
+		t.Errorf("LoadStdDev on an empty ring: got %v, want 0", got)
+	}
+
+	x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+	got := x.Distribution(keys)
+	var total int
+	for node, n := range got {
+		total += n
+		if mean := len(keys) / 3; n > mean*12/10 {
+			t.Errorf("%s: got %d keys, want at most 1.2x the mean %d", node, n, mean)
+		}
+	}
+	if total != len(keys) {
+		t.Errorf("Distribution: got %d keys in all, want %d", total, len(keys))
+	}
+	if got := x.Distribution(nil); !maps.Equal(got, map[string]int{"abcdefg": 0, "hijklmn": 0, "opqrstu": 0}) {
+		t.Errorf("Distribution(nil): got %v, want all nodes with 0", got)
+	}
+
+	even := x.LoadStdDev(keys)
+	x.SetWeight("abcdefg", 4)
+	if skewed := x.LoadStdDev(keys); !(skewed > even) {
+		t.Errorf("LoadStdDev of a weighted ring: got %v, want more than %v of the even ring", skewed, even)
+	}
+}
+
+func TestWraparound(t *testing.T) {
+	// names are hashed to the numbers they spell, so that they can be placed around the positions of nodes
+	numeric := HashFunc(func(k string) []uint32 {
+		n, _ := strconv.ParseUint(k, 10, 32)
+		return []uint32{uint32(n)}
+	})
+	for _, nearest := range []bool{false, true} {
+		x := New(WithHashAlgorithm[string](numeric), WithHashRingNodeHashAlg[string](KetamaHash),
+			WithHashRingNearest[string](nearest))
+		x.AddNodes("abcdefg", "hijklmn", "opqrstu")
+		minKey, maxKey := x.sortedKeys[0], x.sortedKeys[len(x.sortedKeys)-1]
+		if minKey == 0 || maxKey == math.MaxUint32 {
+			t.Fatalf("positions [%d, %d] leave no room around them", minKey, maxKey)
+		}
+		first, last := x.nodeByKey[minKey], x.nodeByKey[maxKey]
+
+		for _, tt := range []struct {
+			hash uint32
+			want string
+		}{
+			{0, first},
+			{minKey - 1, first},
+			{minKey, first},
+			{maxKey, last},
+			{maxKey + 1, first}, // past the last position wraps around to the first one
+			{math.MaxUint32, first},
+		} {
+			if nearest && tt.hash == maxKey+1 {
+				tt.want = last // closer to the last position than to the first one
+			}
+			name := strconv.FormatUint(uint64(tt.hash), 10)
+			for i := 0; i < 3; i++ {
+				if got, _ := x.Get(name); got != tt.want {
+					t.Errorf("nearest %t: Get(%d): got %q, want %q", nearest, tt.hash, got, tt.want)
+				}
+				if got := getN(x, name, 3); len(got) != 3 || got[0] != tt.want {
+					t.Errorf("nearest %t: GetSince(%d): got %v, want %q first", nearest, tt.hash, got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+func TestEstimateNodesForBalance(t *testing.T) {
+	if got := EstimateNodesForBalance(0, 160); got != 0 {
+		t.Errorf("EstimateNodesForBalance(0, 160): got %d, want 0", got)
+	}
+	if got := EstimateNodesForBalance(1, 160); got != 1 {
+		t.Errorf("EstimateNodesForBalance(1, 160): got %d, want 1", got)
+	}
+	if got, want := EstimateNodesForBalance(0.2, 0), EstimateNodesForBalance(0.2, defaultNumReps); got != want {
+		t.Errorf("EstimateNodesForBalance(0.2, 0): got %d, want %d as for the default", got, want)
+	}
+
+	for _, numReps := range []int{10, 160, 512} {
+		prev := math.MaxInt
+		for share := 0.01; share <= 1; share += 0.01 {
+			n := EstimateNodesForBalance(share, numReps)
+			if n > prev {
+				t.Errorf("EstimateNodesForBalance(%.2f, %d): got %d, want no more than %d for a smaller share", share, numReps, n, prev)
+			}
+			if float64(n) < 1/share-1e-9 {
+				t.Errorf("EstimateNodesForBalance(%.2f, %d): got %d, fewer than an even spread needs", share, numReps, n)
+			}
+			prev = n
+		}
+	}
+	// fewer virtual nodes spread keys less evenly, thus need more nodes
+	if few, many := EstimateNodesForBalance(0.1, 10), EstimateNodesForBalance(0.1, 512); few <= many {
+		t.Errorf("EstimateNodesForBalance(0.1): got %d nodes of 10 virtual nodes, want more than %d of 512", few, many)
+	}
+
+	// a ring of the estimated size is about as balanced as targeted
+	const target = 0.15
+	x := New[string]()
+	for i := range EstimateNodesForBalance(target, defaultNumReps) {
+		x.AddNodes("node" + strconv.Itoa(i))
+	}
+	keys := make([]string, 20000)
+	for i := range keys {
+		keys[i] = "key" + strconv.Itoa(i)
+	}
+	for node, n := range x.Distribution(keys) {
+		if share := float64(n) / float64(len(keys)); share > target*1.2 {
+			t.Errorf("%s: got a share of %.3f, want about %.2f at most", node, share, target)
+		}
+	}
+}
+
+func TestAddNodeWithReplicas(t *testing.T) {
+	for _, weighted := range []bool{false, true} {
+		x := New[string]()
+		x.AddNodes("abcdefg", "hijklmn")
+		if weighted {
+			x.SetWeight("abcdefg", 1)
+			x.SetWeight("hijklmn", 3)
+		}
+		x.AddNodeWithReplicas("opqrstu", 500)
+		want := map[string]int{"abcdefg": 160, "hijklmn": 160, "opqrstu": 500}
+		if weighted {
+			// the other nodes share their virtual nodes by their weights, as if opqrstu was absent
+			want = map[string]int{"abcdefg": 80, "hijklmn": 240, "opqrstu": 500}
+		}
+		for node, n := range want {
+			if got := len(x.Replicas(node)); got != n {
+				t.Errorf("weighted %t: Replicas(%q): got %d virtual nodes, want %d", weighted, node, got, n)
+			}
+		}
+
+		// placing a present node again replaces its virtual nodes
+		x.AddNodeWithReplicas("abcdefg", 7)
+		if got := len(x.Replicas("abcdefg")); got != 7 {
+			t.Errorf("weighted %t: Replicas(%q) placed again: got %d virtual nodes, want 7", weighted, "abcdefg", got)
+		}
+		if got, want := len(x.sortedKeys), 7+want["hijklmn"]+500; !weighted && got != want {
+			t.Errorf("weighted %t: got %d virtual nodes in all, want %d", weighted, got, want)
+		}
+
+		// removing a node forgets its number of virtual nodes
+		x.RemoveNodes("opqrstu")
+		if got := len(x.Replicas("hijklmn")) + len(x.Replicas("abcdefg")); got != len(x.sortedKeys) {
+			t.Errorf("weighted %t: RemoveNodes: got %d virtual nodes left, want %d", weighted, len(x.sortedKeys), got)
+		}
+		x.AddNodes("opqrstu")
+		if got := len(x.Replicas("opqrstu")); got == 500 {
+			t.Errorf("weighted %t: Replicas(%q) added again: got 500 virtual nodes, want the default", weighted, "opqrstu")
+		}
+
+		// survives a round trip in JSON
+		b, err := json.Marshal(x)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var y HashRing[string]
+		if err := json.Unmarshal(b, &y); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Equal(x.sortedKeys, y.sortedKeys) {
+			t.Errorf("weighted %t: JSON round trip: got %d virtual nodes, want %d placed identically", weighted, len(y.sortedKeys), len(x.sortedKeys))
+		}
+	}
+}
+
+func TestPositions(t *testing.T) {
+	x := New[string]()
+	for range x.Positions() {
+		t.Fatalf("Positions on an empty ring: got a position, want none")
+	}
+
+	x.AddNodes("abcdefg", "hijklmn")
+	var positions []uint32
+	for pos, node := range x.Positions() {
+		if want := x.nodeByKey[pos]; node != want {
+			t.Errorf("Positions: got %q at %d, want %q", node, pos, want)
+		}
+		positions = append(positions, pos)
+	}
+	if !slices.Equal(positions, x.sortedKeys) || !slices.IsSorted(positions) {
+		t.Errorf("Positions: got %d positions, want the %d positions in ascending order", len(positions), len(x.sortedKeys))
+	}
+
+	// stops at break
+	var n int
+	for range x.Positions() {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Errorf("Positions stopped at 3: got %d positions", n)
+	}
+}
+
+func TestWriteDOT(t *testing.T) {
+	var b strings.Builder
+	if err := New[string]().WriteDOT(&b); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := b.String(), "digraph hashring {\n}\n"; got != want {
+		t.Errorf("WriteDOT on an empty ring: got %q, want %q", got, want)
+	}
+
+	x := New(WithNumReplicas[string](2))
+	x.AddNodes("abcdefg", "hijklmn")
+	b.Reset()
+	if err := x.WriteDOT(&b); err != nil {
+		t.Fatal(err)
+	}
+	got := b.String()
+	var want strings.Builder
+	want.WriteString("digraph hashring {\n\tnode [shape=box, style=filled];\n")
+	hues := map[string]string{"abcdefg": "0.000", "hijklmn": "0.500"}
+	for i, k := range x.sortedKeys {
+		node := x.nodeByKey[k]
+		fmt.Fprintf(&want, "\tp%d [label=\"%d\\n%s\", fillcolor=\"%s 0.400 1.000\"];\n", i, k, node, hues[node])
+	}
+	want.WriteString("\tp0 -> p1;\n\tp1 -> p2;\n\tp2 -> p3;\n\tp3 -> p0;\n}\n")
+	if got != want.String() {
+		t.Errorf("WriteDOT:\n%s\nwant:\n%s", got, want.String())
+	}
+
+	// stable
+	b.Reset()
+	if err := x.Clone().WriteDOT(&b); err != nil || b.String() != got {
+		t.Errorf("WriteDOT of a clone: got %q, %v, want %q", b.String(), err, got)
+	}
+}
+
+func TestMeta(t *testing.T) {
+	type meta struct {
+		dc      string
+		healthy bool
+	}
+	x := New[string]()
+	x.AddNodes("abcdefg", "hijklmn")
+	x.SetMeta("abcdefg", meta{"us-east", true})
+	x.SetMeta("nonexistent", meta{"us-west", true})
+	if _, ok := x.Meta("nonexistent"); ok {
+		t.Errorf("Meta of a node not in the ring: got ok true, want false")
+	}
+	if _, ok := x.Meta("hijklmn"); ok {
+		t.Errorf("Meta of a node without metadata: got ok true, want false")
+	}
+
+	// the metadata of the node got is retrieved, and survives mutations of other nodes
+	x.AddNodes("opqrstu")
+	x.RemoveNodes("hijklmn")
+	x.SetNodes("abcdefg", "opqrstu", "vwxyz")
+	x.SetMeta("opqrstu", meta{"eu-west", false})
+	for _, node := range []string{"abcdefg", "opqrstu"} {
+		name := node
+		for i := 0; ; i++ {
+			if got, _ := x.Get(name); got == node {
+				break
+			}
+			name = node + strconv.Itoa(i)
+		}
+		got, _ := x.Get(name)
+		m, ok := x.Meta(got)
+		want := map[string]meta{"abcdefg": {"us-east", true}, "opqrstu": {"eu-west", false}}[node]
+		if !ok || m != want {
+			t.Errorf("Meta(%q) of Get(%q): got %v, %t, want %v", got, name, m, ok, want)
+		}
+	}
+	c := x.Clone()
+
+	// dropped once the node is removed, even if added back
+	x.RemoveNodes("abcdefg")
+	x.AddNodes("abcdefg")
+	if _, ok := x.Meta("abcdefg"); ok {
+		t.Errorf("Meta of a node removed and added back: got ok true, want false")
+	}
+	x.SetNodes("vwxyz")
+	if _, ok := x.Meta("opqrstu"); ok {
+		t.Errorf("Meta of a node removed by SetNodes: got ok true, want false")
+	}
+	if m, ok := c.Meta("abcdefg"); !ok || m != (meta{"us-east", true}) {
+		t.Errorf("Meta of a clone: got %v, %t, want unchanged by the original", m, ok)
+	}
+	c.RemoveAllNodes()
+	c.AddNodes("opqrstu")
+	if _, ok := c.Meta("opqrstu"); ok {
+		t.Errorf("Meta after RemoveAllNodes: got ok true, want false")
+	}
+}
+
+func TestRemoveNodesFunc(t *testing.T) {
+	nodes := []string{"us-east-1", "us-east-2", "eu-west-1", "eu-west-2", "ap-south-1"}
+	for _, weighted := range []bool{false, true} {
+		weights := make(map[string]int)
+		for i, node := range nodes {
+			weights[node] = i + 1
+		}
+		newRing := func(nodes ...string) *HashRing[string] {
+			x := New[string](WithHashRingIsWeighted[string](weighted), WithHashRingWeightByNode[string](maps.Clone(weights)))
+			x.AddNodes(nodes...)
+			return x
+		}
+		x := newRing(nodes...)
+		sortedKeys := slices.Clone(x.sortedKeys)
+
+		if n := x.RemoveNodesFunc(func(string) bool { return false }); n != 0 {
+			t.Errorf("RemoveNodesFunc(none): got %d, want 0", n)
+		}
+		if !slices.Equal(x.sortedKeys, sortedKeys) {
+			t.Errorf("RemoveNodesFunc(none): got the cycle changed, want untouched")
+		}
+
+		var called int
+		if n := x.RemoveNodesFunc(func(node string) bool {
+			called++
+			return strings.HasPrefix(node, "us-")
+		}); n != 2 {
+			t.Errorf("RemoveNodesFunc(us-): got %d, want 2", n)
+		}
+		if called != len(nodes) {
+			t.Errorf("RemoveNodesFunc(us-): got pred called %d times, want %d", called, len(nodes))
+		}
+		remaining := []string{"ap-south-1", "eu-west-1", "eu-west-2"}
+		if got := slices.Sorted(x.All()); !slices.Equal(got, remaining) {
+			t.Errorf("RemoveNodesFunc(us-): got nodes %v, want %v", got, remaining)
+		}
+		if want := newRing(remaining...); !slices.Equal(x.sortedKeys, want.sortedKeys) || !maps.Equal(x.nodeByKey, want.nodeByKey) {
+			t.Errorf("RemoveNodesFunc(us-): got a cycle other than the one of the nodes remaining")
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"context"
+	"iter"
+)
+
+// Buffered returns an iterator over the values in seq, pulled ahead by a goroutine into a buffer of size values,
+// so that a bursty producer is not held back by a slow consumer until the buffer is full, such as to smooth a source.
+// A size of 0 or less leaves the producer one value ahead at most.
+//
+// Iterating stops without yielding more once ctx is done. Once iterating stops, early or not,
+// the producer is stopped and waited for, so that no goroutine is leaked: seq cannot be interrupted between values,
+// so the producer stops once seq yields the next value it was producing, if any, which is dropped,
+// as are values already buffered. A panic in seq is raised again in the consumer.
+func Buffered[V any](ctx context.Context, seq iter.Seq[V], size int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		values := make(chan V, max(size, 0))
+		var panicked bool
+		var panicValue any
+		go func() {
+			defer close(values)
+			defer func() {
+				if r := recover(); r != nil {
+					panicked, panicValue = true, r
+				}
+			}()
+			for v := range seq {
+				select {
+				case values <- v:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		defer func() {
+			cancel()
+			for range values {
+				// drop buffered values, until the producer exits
+			}
+			if panicked {
+				panic(panicValue)
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case v, ok := <-values:
+				if !ok || ctx.Err() != nil {
+					return
+				}
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+func TestBuffered(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	for _, size := range []int{-1, 0, 1, 2, 10} {
+		if got := slices.Collect(iter_.Buffered(context.Background(), slices.Values(data), size)); !slices.Equal(got, data) {
+			t.Errorf("Buffered(%d) = %v, want %v", size, got, data)
+		}
+	}
+
+	// the producer runs ahead of a slow consumer, up to the buffer
+	var produced atomic.Int32
+	seq := func(yield func(int) bool) {
+		for _, v := range data {
+			produced.Add(1)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	for range iter_.Buffered(context.Background(), seq, 3) {
+		time.Sleep(20 * time.Millisecond)
+		// the value yielded, 3 buffered and one waiting to be sent at most
+		if got := produced.Load(); got < 4 {
+			t.Errorf("Buffered(3): %d values produced while consuming the first one, want at least 4", got)
+		}
+		break
+	}
+}
+
+// stoppedSeq is a sequence of all ints, reporting on stopped once it returns.
+func stoppedSeq(stopped chan<- struct{}) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		defer close(stopped)
+		for i := 0; ; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestBufferedBreak(t *testing.T) {
+	stopped := make(chan struct{})
+	var got []int
+	for v := range iter_.Buffered(context.Background(), stoppedSeq(stopped), 2) {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{0, 1, 2}) {
+		t.Errorf("Buffered() stopped at 2 = %v, want [0 1 2]", got)
+	}
+	select {
+	case <-stopped:
+	default:
+		t.Errorf("Buffered() stopped at 2: the producer is still running")
+	}
+}
+
+func TestBufferedCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped := make(chan struct{})
+	var got []int
+	for v := range iter_.Buffered(ctx, stoppedSeq(stopped), 2) {
+		got = append(got, v)
+		if v == 1 {
+			cancel()
+		}
+	}
+	if !slices.Equal(got, []int{0, 1}) {
+		t.Errorf("Buffered() canceled at 1 = %v, want [0 1]", got)
+	}
+	select {
+	case <-stopped:
+	default:
+		t.Errorf("Buffered() canceled at 1: the producer is still running")
+	}
+
+	// a producer blocked on a slow source stops with ctx
+	ctx, cancel = context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	slow := func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			time.Sleep(5 * time.Millisecond)
+			if !yield(i) {
+				return
+			}
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for range iter_.Buffered(ctx, slow, 0) {
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatalf("Buffered() with ctx done: still iterating")
+	}
+}
+
+func TestBufferedPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("Buffered() of a panicking seq: got panic %v, want boom", r)
+		}
+	}()
+	seq := func(yield func(int) bool) {
+		if yield(1) {
+			panic("boom")
+		}
+	}
+	for range iter_.Buffered(context.Background(), seq, 0) {
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"iter"
+)
+
+// CollectN collects values from seq into a new slice and returns it.
+// The returned slice is preallocated with capacity sizeHint, which avoids
+// reallocations when the length of seq is known, or can be estimated, in advance.
+// A negative sizeHint is treated as zero.
+func CollectN[V any](seq iter.Seq[V], sizeHint int) []V {
+	s := make([]V, 0, max(sizeHint, 0))
+	for v := range seq {
+		s = append(s, v)
+	}
+	return s
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+func TestCollectN(t *testing.T) {
+	tests := []struct {
+		data     []int
+		sizeHint int
+		want     []int
+	}{
+		{nil, 0, []int{}},
+		{[]int{}, -1, []int{}},
+		{[]int{}, 1, []int{}},
+		{[]int{1, 2}, -1, []int{1, 2}},
+		{[]int{1, 2}, 0, []int{1, 2}},
+		{[]int{1, 2}, 1, []int{1, 2}},
+		{[]int{1, 2}, 2, []int{1, 2}},
+		{[]int{1, 2}, 3, []int{1, 2}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v, %d", i, tt.data, tt.sizeHint), func(t *testing.T) {
+			got := iter_.CollectN(slices.Values(tt.data), tt.sizeHint)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.CollectN(%v, %d) = %v, want %v", tt.data, tt.sizeHint, got, tt.want)
+			}
+			if c := cap(got); c < tt.sizeHint {
+				t.Errorf("iter_.CollectN(%v, %d) cap = %d, want >= %d", tt.data, tt.sizeHint, c, tt.sizeHint)
+			}
+		})
+	}
+}
+
+func rangeSeq(n int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := range n {
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func BenchmarkCollect(b *testing.B) {
+	const n = 1 << 16
+	b.Run("slices.Collect", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = slices.Collect(rangeSeq(n))
+		}
+	})
+	b.Run("CollectN", func(b *testing.B) {
+		b.ReportAllocs()
+		for range b.N {
+			_ = iter_.CollectN(rangeSeq(n), n)
+		}
+	})
+}
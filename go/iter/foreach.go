@@ -0,0 +1,31 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"iter"
+)
+
+// ForEach calls f for each value in seq, stopping at the first non-nil error, which is returned.
+// It returns nil if f succeeds for all values in seq.
+func ForEach[V any](seq iter.Seq[V], f func(V) error) error {
+	for v := range seq {
+		if err := f(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ForEach2 calls f for each pair of values in seq, stopping at the first non-nil error, which is returned.
+// It returns nil if f succeeds for all pairs of values in seq.
+func ForEach2[K, V any](seq iter.Seq2[K, V], f func(K, V) error) error {
+	for k, v := range seq {
+		if err := f(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+	"testing"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+var errStop = errors.New("stop")
+
+// trackedSeq returns seq over data, and reports by released whether seq has returned.
+func trackedSeq[V any](data []V) (seq iter.Seq2[int, V], released *bool) {
+	released = new(bool)
+	return func(yield func(int, V) bool) {
+		*released = false
+		defer func() { *released = true }()
+		for i, v := range data {
+			if !yield(i, v) {
+				return
+			}
+		}
+	}, released
+}
+
+func values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestForEach(t *testing.T) {
+	tests := []struct {
+		data    []int
+		stopAt  int // f fails on the value, -1 for never
+		want    []int
+		wantErr error
+	}{
+		{nil, -1, nil, nil},
+		{[]int{}, -1, nil, nil},
+		{[]int{1, 2, 3}, -1, []int{1, 2, 3}, nil},
+		{[]int{1, 2, 3}, 1, []int{1}, errStop},
+		{[]int{1, 2, 3}, 2, []int{1, 2}, errStop},
+		{[]int{1, 2, 3}, 3, []int{1, 2, 3}, errStop},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.data), func(t *testing.T) {
+			seq, released := trackedSeq(tt.data)
+			var got []int
+			err := iter_.ForEach(values(seq), func(v int) error {
+				got = append(got, v)
+				if v == tt.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("iter_.ForEach(%v) = %v, want %v", tt.data, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.ForEach(%v) visited %v, want %v", tt.data, got, tt.want)
+			}
+			if !*released {
+				t.Errorf("iter_.ForEach(%v) did not release the iterator", tt.data)
+			}
+		})
+	}
+}
+
+func TestForEach2(t *testing.T) {
+	tests := []struct {
+		data    []string
+		stopAt  int // f fails on the index, -1 for never
+		want    []string
+		wantErr error
+	}{
+		{nil, -1, nil, nil},
+		{[]string{}, -1, nil, nil},
+		{[]string{"a", "b", "c"}, -1, []string{"0a", "1b", "2c"}, nil},
+		{[]string{"a", "b", "c"}, 0, []string{"0a"}, errStop},
+		{[]string{"a", "b", "c"}, 1, []string{"0a", "1b"}, errStop},
+		{[]string{"a", "b", "c"}, 2, []string{"0a", "1b", "2c"}, errStop},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.data), func(t *testing.T) {
+			seq, released := trackedSeq(tt.data)
+			var got []string
+			err := iter_.ForEach2(seq, func(k int, v string) error {
+				got = append(got, fmt.Sprint(k, v))
+				if k == tt.stopAt {
+					return errStop
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("iter_.ForEach2(%v) = %v, want %v", tt.data, err, tt.wantErr)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.ForEach2(%v) visited %v, want %v", tt.data, got, tt.want)
+			}
+			if !*released {
+				t.Errorf("iter_.ForEach2(%v) did not release the iterator", tt.data)
+			}
+		})
+	}
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"iter"
+	"strings"
+)
+
+// Intersperse returns an iterator that yields the values in seq with sep between each two consecutive ones,
+// neither before the first nor after the last.
+func Intersperse[V any](seq iter.Seq[V], sep V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		var started bool
+		for v := range seq {
+			if started && !yield(sep) {
+				break
+			}
+			started = true
+			if !yield(v) {
+				break
+			}
+		}
+	}
+}
+
+// Join concatenates the strings in seq to create a single string,
+// with sep placed between the strings in the resulting string, as strings.Join does.
+func Join[S ~string](seq iter.Seq[S], sep S) S {
+	var b strings.Builder
+	for s := range Intersperse(seq, sep) {
+		b.WriteString(string(s))
+	}
+	return S(b.String())
+}
@@ -0,0 +1,68 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+func TestIntersperse(t *testing.T) {
+	tests := []struct {
+		data []int
+		want []int
+	}{
+		{nil, nil},
+		{[]int{}, nil},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2}, []int{1, 0, 2}},
+		{[]int{1, 2, 3}, []int{1, 0, 2, 0, 3}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.data), func(t *testing.T) {
+			got := slices.Collect(iter_.Intersperse(slices.Values(tt.data), 0))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.Intersperse(%v, 0) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIntersperseBreak(t *testing.T) {
+	for n := range 5 {
+		var got []int
+		for v := range iter_.Intersperse(slices.Values([]int{1, 2, 3}), 0) {
+			if len(got) == n {
+				break
+			}
+			got = append(got, v)
+		}
+		if want := []int{1, 0, 2, 0, 3}[:n]; !slices.Equal(got, want) {
+			t.Errorf("iter_.Intersperse([1 2 3], 0) break after %d = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		data []string
+		want string
+	}{
+		{nil, ""},
+		{[]string{"a"}, "a"},
+		{[]string{"a", "b"}, "a, b"},
+		{[]string{"a", "", "c"}, "a, , c"},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.data), func(t *testing.T) {
+			if got := iter_.Join(slices.Values(tt.data), ", "); got != tt.want {
+				t.Errorf("iter_.Join(%q, \", \") = %q, want %q", tt.data, got, tt.want)
+			}
+		})
+	}
+}
@@ -25,3 +25,18 @@ func MapFunc[V, M any](seq iter.Seq[V], f func(V) M) iter.Seq[M] {
 		}
 	}
 }
+
+// FlatMap returns an iterator that yields the individual values within all sub-sequences f(v) for all v in the sequences,
+// concatenated in order. The sub-sequences are iterated lazily, one at a time, and the iteration stops at once,
+// inner sub-sequence included, when the consumer stops.
+func FlatMap[V, M any](seq iter.Seq[V], f func(V) iter.Seq[M]) iter.Seq[M] {
+	return func(yield func(M) bool) {
+		for v := range seq {
+			for m := range f(v) {
+				if !yield(m) {
+					return
+				}
+			}
+		}
+	}
+}
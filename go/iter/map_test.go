@@ -6,6 +6,7 @@ package iter_test
 
 import (
 	"fmt"
+	"iter"
 	"slices"
 	"strconv"
 	"testing"
@@ -58,3 +59,64 @@ func TestMapFunc(t *testing.T) {
 		})
 	}
 }
+
+func TestFlatMap(t *testing.T) {
+	repeat := func(n int) iter.Seq[int] { return slices.Values(slices.Repeat([]int{n}, n)) }
+	tests := []struct {
+		data []int
+		want []int
+	}{
+		{nil, nil},
+		{[]int{}, nil},
+		{[]int{0}, nil},
+		{[]int{1}, []int{1}},
+		{[]int{0, 2, 0}, []int{2, 2}},
+		{[]int{1, 2, 3}, []int{1, 2, 2, 3, 3, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%v", tt.data), func(t *testing.T) {
+			got := slices.Collect(iter_.FlatMap(slices.Values(tt.data), repeat))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.FlatMap(%v, repeat) = %v, want %v", tt.data, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMapBreak(t *testing.T) {
+	var outer, inner []int
+	seq := func(yield func(int) bool) {
+		for _, v := range []int{1, 2, 3} {
+			outer = append(outer, v)
+			if !yield(v) {
+				return
+			}
+		}
+	}
+	f := func(v int) iter.Seq[int] {
+		return func(yield func(int) bool) {
+			for i := range 3 {
+				inner = append(inner, v*10+i)
+				if !yield(v*10 + i) {
+					return
+				}
+			}
+		}
+	}
+	var got []int
+	for v := range iter_.FlatMap(seq, f) {
+		got = append(got, v)
+		if len(got) == 4 {
+			break
+		}
+	}
+	if want := []int{10, 11, 12, 20}; !slices.Equal(got, want) {
+		t.Errorf("iter_.FlatMap break after 4 = %v, want %v", got, want)
+	}
+	if want := []int{1, 2}; !slices.Equal(outer, want) {
+		t.Errorf("iter_.FlatMap break after 4: outer pulled %v, want %v", outer, want)
+	}
+	if want := []int{10, 11, 12, 20}; !slices.Equal(inner, want) {
+		t.Errorf("iter_.FlatMap break after 4: inner pulled %v, want %v", inner, want)
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"iter"
+)
+
+// Memoize returns an iterator over the values in seq, which runs seq once at most, on the first iteration,
+// caching all its values in a slice, and serves the next iterations from the cache,
+// such as to iterate an expensive or one-shot source more than once, trading memory for re-iterability.
+//
+// The first iteration yields values as seq produces them, and buffers them all:
+// if it stops early, seq is still consumed in full, without yielding the rest, so that the cache is complete.
+// If seq panics, nothing is cached, and the next iteration runs seq again.
+// The iterator returned is not safe for concurrent use.
+func Memoize[V any](seq iter.Seq[V]) iter.Seq[V] {
+	var cache []V
+	var cached bool
+	return func(yield func(V) bool) {
+		if cached {
+			for _, v := range cache {
+				if !yield(v) {
+					return
+				}
+			}
+			return
+		}
+		var values []V
+		yielding := true
+		for v := range seq {
+			values = append(values, v)
+			if yielding && !yield(v) {
+				yielding = false
+			}
+		}
+		cache, cached = values, true
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"slices"
+	"testing"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+func TestMemoize(t *testing.T) {
+	data := []int{1, 2, 3, 4, 5}
+	var runs int
+	seq := func(yield func(int) bool) {
+		runs++
+		for _, v := range data {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	memo := iter_.Memoize(seq)
+	for i := 0; i < 2; i++ {
+		if got := slices.Collect(memo); !slices.Equal(got, data) {
+			t.Errorf("Memoize() iteration %d = %v, want %v", i, got, data)
+		}
+	}
+	if runs != 1 {
+		t.Errorf("Memoize(): seq run %d times, want 1", runs)
+	}
+
+	// a first iteration stopped early still caches all the values
+	runs = 0
+	memo = iter_.Memoize(seq)
+	var got []int
+	for v := range memo {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Memoize() stopped at 2 = %v, want [1 2]", got)
+	}
+	if got := slices.Collect(memo); !slices.Equal(got, data) {
+		t.Errorf("Memoize() after stopped early = %v, want %v", got, data)
+	}
+	if runs != 1 {
+		t.Errorf("Memoize() stopped early: seq run %d times, want 1", runs)
+	}
+}
+
+func TestMemoizePanic(t *testing.T) {
+	var runs int
+	seq := func(yield func(int) bool) {
+		runs++
+		if !yield(1) {
+			return
+		}
+		if runs == 1 {
+			panic("boom")
+		}
+	}
+	memo := iter_.Memoize(seq)
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Memoize() of a panicking seq: got panic %v, want boom", r)
+			}
+		}()
+		for range memo {
+		}
+	}()
+
+	// nothing is cached, so seq runs again
+	if got := slices.Collect(memo); !slices.Equal(got, []int{1}) {
+		t.Errorf("Memoize() after a panic = %v, want [1]", got)
+	}
+	if runs != 2 {
+		t.Errorf("Memoize() after a panic: seq run %d times, want 2", runs)
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// ParallelMap returns an iterator over f applied to the values in seq, run by workers goroutines concurrently,
+// such as for expensive per-value work, yielding the results in the order of the values in seq all the same.
+// Results completed out of order are buffered until the ones before them are yielded,
+// so that about 2*workers values are in flight at most, pulled from seq ahead of the consumer.
+// A workers of 0 or less runs f by one goroutine.
+//
+// Iterating stops without yielding more once ctx is done. Once iterating stops, early or not,
+// the goroutines are stopped before the next value they pull, and waited for, so that no goroutine is leaked;
+// calls of f in progress run to completion, and their results are dropped.
+// A panic in seq or f is raised again in the consumer.
+func ParallelMap[V, U any](ctx context.Context, seq iter.Seq[V], workers int, f func(V) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		workers := max(workers, 1)
+		type job struct {
+			v      V
+			result chan U
+		}
+		jobs := make(chan job)
+		// the channels of the results, in the order of the values in seq
+		results := make(chan chan U, workers)
+
+		var wg sync.WaitGroup
+		var panicOnce sync.Once
+		var panicked bool
+		var panicValue any
+		recoverPanic := func() {
+			if r := recover(); r != nil {
+				panicOnce.Do(func() { panicked, panicValue = true, r })
+				cancel()
+			}
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer close(results)
+			defer close(jobs)
+			defer recoverPanic()
+			for v := range seq {
+				result := make(chan U, 1)
+				select {
+				case results <- result:
+				case <-ctx.Done():
+					return
+				}
+				select {
+				case jobs <- job{v: v, result: result}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		for range workers {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer recoverPanic()
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case j, ok := <-jobs:
+						if !ok {
+							return
+						}
+						j.result <- f(j.v)
+					}
+				}
+			}()
+		}
+		defer func() {
+			cancel()
+			wg.Wait()
+			if panicked {
+				panic(panicValue)
+			}
+		}()
+
+		for {
+			var result chan U
+			select {
+			case <-ctx.Done():
+				return
+			case r, ok := <-results:
+				if !ok {
+					return
+				}
+				result = r
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case u := <-result:
+				if ctx.Err() != nil {
+					return
+				}
+				if !yield(u) {
+					return
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+func TestParallelMap(t *testing.T) {
+	var data []int
+	for i := 0; i < 50; i++ {
+		data = append(data, i)
+	}
+	var want []int
+	for _, v := range data {
+		want = append(want, v*v)
+	}
+	for _, workers := range []int{-1, 0, 1, 4, 100} {
+		var running, maxRunning atomic.Int32
+		square := func(v int) int {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for m := maxRunning.Load(); n > m && !maxRunning.CompareAndSwap(m, n); m = maxRunning.Load() {
+			}
+			// complete out of order
+			time.Sleep(time.Duration((len(data)-v)%7) * time.Millisecond)
+			return v * v
+		}
+		got := slices.Collect(iter_.ParallelMap(context.Background(), slices.Values(data), workers, square))
+		if !slices.Equal(got, want) {
+			t.Errorf("ParallelMap(%d) = %v, want %v", workers, got, want)
+		}
+		if m := int(maxRunning.Load()); m > max(workers, 1) {
+			t.Errorf("ParallelMap(%d): %d calls of f running at once, want %d at most", workers, m, max(workers, 1))
+		}
+	}
+}
+
+func TestParallelMapStop(t *testing.T) {
+	var calls atomic.Int32
+	double := func(v int) int {
+		calls.Add(1)
+		return v * 2
+	}
+	stopped := make(chan struct{})
+	var got []int
+	for v := range iter_.ParallelMap(context.Background(), stoppedSeq(stopped), 2, double) {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{0, 2}) {
+		t.Errorf("ParallelMap() stopped at 2 = %v, want [0 2]", got)
+	}
+	select {
+	case <-stopped:
+	default:
+		t.Errorf("ParallelMap() stopped at 2: the producer is still running")
+	}
+	n := calls.Load()
+	time.Sleep(20 * time.Millisecond)
+	if calls.Load() != n {
+		t.Errorf("ParallelMap() stopped at 2: f still called")
+	}
+
+	// canceled
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stopped = make(chan struct{})
+	got = nil
+	for v := range iter_.ParallelMap(ctx, stoppedSeq(stopped), 3, double) {
+		got = append(got, v)
+		if v == 4 {
+			cancel()
+		}
+	}
+	if !slices.Equal(got, []int{0, 2, 4}) {
+		t.Errorf("ParallelMap() canceled at 4 = %v, want [0 2 4]", got)
+	}
+	select {
+	case <-stopped:
+	default:
+		t.Errorf("ParallelMap() canceled at 4: the producer is still running")
+	}
+}
+
+func TestParallelMapPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("ParallelMap() of a panicking f: got panic %v, want boom", r)
+		}
+	}()
+	f := func(v int) int {
+		if v == 3 {
+			panic("boom")
+		}
+		return v
+	}
+	for range iter_.ParallelMap(context.Background(), slices.Values([]int{1, 2, 3, 4, 5}), 2, f) {
+	}
+}
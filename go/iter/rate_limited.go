@@ -0,0 +1,32 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"context"
+	"iter"
+
+	"github.com/searKing/golang/go/time/rate"
+)
+
+// RateLimited returns an iterator over the values in seq, taking a token from lim by lim.Wait(ctx)
+// before yielding each of them, so that the consumer is paced by lim.
+// The tokens taken are not put back: BurstLimiter has no automatic refill, so the pace is that of
+// the tokens put back by PutToken, such as by a time.Ticker, or by the consumer once done with a value
+// to bound the values in flight.
+// Iterating blocks while lim has no token, and stops without yielding more once ctx is done,
+// or lim.Wait fails otherwise, such as on a burst of 0.
+func RateLimited[V any](ctx context.Context, seq iter.Seq[V], lim *rate.BurstLimiter) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for v := range seq {
+			if err := lim.Wait(ctx); err != nil {
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
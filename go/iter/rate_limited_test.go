@@ -0,0 +1,82 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	iter_ "github.com/searKing/golang/go/iter"
+	"github.com/searKing/golang/go/time/rate"
+)
+
+func TestRateLimited(t *testing.T) {
+	const interval = 20 * time.Millisecond
+	data := []int{1, 2, 3, 4, 5}
+
+	// one token at a time, put back at the pace of a ticker
+	lim := rate.NewEmptyBurstLimiter(1)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if lim.Tokens() == 0 {
+					lim.PutToken()
+				}
+			}
+		}
+	}()
+
+	start := time.Now()
+	var got []int
+	var at []time.Duration
+	for v := range iter_.RateLimited(context.Background(), slices.Values(data), lim) {
+		got = append(got, v)
+		at = append(at, time.Since(start))
+	}
+	if !slices.Equal(got, data) {
+		t.Errorf("RateLimited() = %v, want %v", got, data)
+	}
+	for i, d := range at {
+		// the i-th value waits for the (i+1)-th tick at least, with some slack for a late ticker
+		if want := time.Duration(i+1) * interval / 2; d < want {
+			t.Errorf("RateLimited(): #%d yielded after %v, want at least %v", i, d, want)
+		}
+	}
+}
+
+func TestRateLimitedCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// two tokens, so that the third value waits forever until canceled
+	lim := rate.NewFullBurstLimiter(2)
+	var got []int
+	for v := range iter_.RateLimited(ctx, slices.Values([]int{1, 2, 3, 4}), lim) {
+		got = append(got, v)
+		if v == 2 {
+			time.AfterFunc(10*time.Millisecond, cancel)
+		}
+	}
+	if want := []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("RateLimited() canceled = %v, want %v", got, want)
+	}
+
+	// a limiter failing ends the sequence, such as one of burst 0 never permitting a value
+	got = nil
+	for v := range iter_.RateLimited(context.Background(), slices.Values([]int{1, 2}), rate.NewFullBurstLimiter(0)) {
+		got = append(got, v)
+	}
+	if len(got) != 0 {
+		t.Errorf("RateLimited() with burst 0 = %v, want none", got)
+	}
+}
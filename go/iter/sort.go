@@ -0,0 +1,38 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// Sorted returns an iterator that yields the values of seq in ascending order.
+// Sorted is not lazy: once iterated, it consumes all of seq and buffers the values before yielding the first one,
+// so seq must be finite, and should be small enough to fit in memory.
+// Each iteration of the returned iterator consumes seq once again.
+func Sorted[V cmp.Ordered](seq iter.Seq[V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range slices.Sorted(seq) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedFunc returns an iterator that yields the values of seq in ascending order, as determined by the cmp function.
+// The sort is stable, values comparing equal are yielded in the order of seq.
+// SortedFunc is not lazy, as Sorted is not.
+func SortedFunc[V any](seq iter.Seq[V], cmp func(V, V) int) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range slices.SortedStableFunc(seq, cmp) {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
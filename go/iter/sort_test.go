@@ -0,0 +1,98 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+	"strings"
+	"testing"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+// countedSeq returns seq over data, and counts by iterations how many times seq has been iterated.
+func countedSeq[V any](data []V) (seq iter.Seq[V], iterations *int) {
+	iterations = new(int)
+	return func(yield func(V) bool) {
+		*iterations++
+		for _, v := range data {
+			if !yield(v) {
+				return
+			}
+		}
+	}, iterations
+}
+
+func TestSorted(t *testing.T) {
+	tests := []struct {
+		data []int
+		want []int
+	}{
+		{nil, nil},
+		{[]int{}, nil},
+		{[]int{1}, []int{1}},
+		{[]int{1, 2, 3}, []int{1, 2, 3}},
+		{[]int{3, 1, 2}, []int{1, 2, 3}},
+		{[]int{3, 1, 3, -1, 2, 1}, []int{-1, 1, 1, 2, 3, 3}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.data), func(t *testing.T) {
+			seq, iterations := countedSeq(tt.data)
+			got := slices.Collect(iter_.Sorted(seq))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.Sorted(%v) = %v, want %v", tt.data, got, tt.want)
+			}
+			if *iterations != 1 {
+				t.Errorf("iter_.Sorted(%v) iterated the source %d times, want 1", tt.data, *iterations)
+			}
+		})
+	}
+}
+
+func TestSortedFunc(t *testing.T) {
+	tests := []struct {
+		data []string
+		want []string
+	}{
+		{nil, nil},
+		{[]string{"b"}, []string{"b"}},
+		{[]string{"b", "A", "a", "C"}, []string{"A", "a", "b", "C"}},
+		{[]string{"a", "B", "A", "b"}, []string{"a", "A", "B", "b"}}, // stable
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.data), func(t *testing.T) {
+			seq, iterations := countedSeq(tt.data)
+			got := slices.Collect(iter_.SortedFunc(seq, func(a, b string) int {
+				return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+			}))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("iter_.SortedFunc(%v) = %v, want %v", tt.data, got, tt.want)
+			}
+			if *iterations != 1 {
+				t.Errorf("iter_.SortedFunc(%v) iterated the source %d times, want 1", tt.data, *iterations)
+			}
+		})
+	}
+}
+
+func TestSortedStop(t *testing.T) {
+	seq, iterations := countedSeq([]int{5, 4, 3, 2, 1})
+	var got []int
+	for v := range iter_.Sorted(seq) {
+		got = append(got, v)
+		if len(got) == 2 {
+			break
+		}
+	}
+	if want := []int{1, 2}; !slices.Equal(got, want) {
+		t.Errorf("iter_.Sorted stopped early = %v, want %v", got, want)
+	}
+	if *iterations != 1 {
+		t.Errorf("iter_.Sorted iterated the source %d times, want 1", *iterations)
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter
+
+import (
+	"context"
+	"iter"
+	"time"
+)
+
+// Throttle returns an iterator over the values in seq, yielding them no faster than one per minInterval,
+// such as to replay events at a controlled pace.
+// The first value is yielded at once, and each next one no sooner than minInterval after the previous one
+// was yielded, so time spent by the consumer on a value counts toward the interval.
+// Iterating blocks, sleeping as needed, and stops without yielding more once ctx is done.
+func Throttle[V any](ctx context.Context, seq iter.Seq[V], minInterval time.Duration) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		var last time.Time
+		var timer *time.Timer
+		defer func() {
+			if timer != nil {
+				timer.Stop()
+			}
+		}()
+		for v := range seq {
+			if d := minInterval - time.Since(last); !last.IsZero() && d > 0 {
+				if timer == nil {
+					timer = time.NewTimer(d)
+				} else {
+					timer.Reset(d)
+				}
+				select {
+				case <-ctx.Done():
+					return
+				case <-timer.C:
+				}
+			}
+			if ctx.Err() != nil {
+				return
+			}
+			last = time.Now()
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
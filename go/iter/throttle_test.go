@@ -0,0 +1,76 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package iter_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	iter_ "github.com/searKing/golang/go/iter"
+)
+
+func TestThrottle(t *testing.T) {
+	const minInterval = 20 * time.Millisecond
+	data := []int{1, 2, 3, 4, 5}
+
+	var got []int
+	var at []time.Time
+	for v := range iter_.Throttle(context.Background(), slices.Values(data), minInterval) {
+		got = append(got, v)
+		at = append(at, time.Now())
+	}
+	if !slices.Equal(got, data) {
+		t.Errorf("Throttle() = %v, want %v", got, data)
+	}
+	for i := 1; i < len(at); i++ {
+		if d := at[i].Sub(at[i-1]); d < minInterval {
+			t.Errorf("Throttle(): #%d yielded %v after #%d, want at least %v", i, d, i-1, minInterval)
+		}
+	}
+
+	// a slow consumer is not slowed down further
+	start := time.Now()
+	for range iter_.Throttle(context.Background(), slices.Values(data), minInterval) {
+		time.Sleep(2 * minInterval)
+	}
+	if d := time.Since(start); d > time.Duration(len(data))*3*minInterval {
+		t.Errorf("Throttle(): a slow consumer took %v, want about %v", d, time.Duration(len(data))*2*minInterval)
+	}
+}
+
+func TestThrottleCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var got []int
+	for v := range iter_.Throttle(ctx, slices.Values([]int{1, 2, 3}), time.Hour) {
+		got = append(got, v)
+		cancel()
+	}
+	if !slices.Equal(got, []int{1}) {
+		t.Errorf("Throttle() canceled after the first value = %v, want [1]", got)
+	}
+
+	got = nil
+	for v := range iter_.Throttle(ctx, slices.Values([]int{1, 2, 3}), 0) {
+		got = append(got, v)
+	}
+	if len(got) != 0 {
+		t.Errorf("Throttle() with ctx done = %v, want none", got)
+	}
+
+	// stops at break
+	got = nil
+	for v := range iter_.Throttle(context.Background(), slices.Values([]int{1, 2, 3}), time.Millisecond) {
+		got = append(got, v)
+		if v == 2 {
+			break
+		}
+	}
+	if !slices.Equal(got, []int{1, 2}) {
+		t.Errorf("Throttle() stopped at 2 = %v, want [1 2]", got)
+	}
+}
@@ -87,6 +87,7 @@ func (c *conn) serve(ctx context.Context) {
 			buf = buf[:runtime.Stack(buf, false)]
 			c.server.logf("mux: panic serving %v: %v\n%s", c.remoteAddr, err, buf)
 		}
+		markDispatched(c.muc)
 		if !c.hijacked() {
 			c.close()
 			c.setState(c.muc, ConnStateClosed)
@@ -103,7 +104,12 @@ func (c *conn) serve(ctx context.Context) {
 	if err != nil {
 		return
 	}
-	serverHandler{c.server}.Serve(rwc)
+	sh := serverHandler{c.server}
+	if _, ok := sh.handler().(*ServeMux); !ok {
+		// no listener to wait for, the handler takes over the connection
+		markDispatched(rwc)
+	}
+	sh.Serve(rwc)
 	c.setState(c.muc, ConnStateIdle)
 }
 
@@ -8,6 +8,7 @@ import (
 	"io"
 
 	"github.com/searKing/golang/go/container/trie_tree/ternary_search_tree"
+	io_ "github.com/searKing/golang/go/io"
 )
 
 // Any is a Matcher that matches any connection.
@@ -15,6 +16,43 @@ func Any() MatcherFunc {
 	return func(io.Writer, io.Reader) bool { return true }
 }
 
+// AnyOf returns a matcher that matches a connection if any of matchers matches it, tried in order,
+// such as AnyOf(HTTP1Fast(), HTTP2()). It matches nothing if matchers is empty.
+// Matchers share the bytes peeked: each one reads the connection from its start,
+// replayed from the bytes buffered by the ones tried before, instead of consuming it.
+func AnyOf(matchers ...Matcher) Matcher {
+	return MatcherFunc(func(w io.Writer, r io.Reader) bool {
+		sniffReader := io_.SniffReader(r)
+		for _, pattern := range matchers {
+			sniffReader.Sniff(true)
+			ok := pattern.Match(w, sniffReader)
+			sniffReader.Sniff(false)
+			if ok {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// AllOf returns a matcher that matches a connection only if all of matchers match it, tried in order,
+// such as AllOf(TLS(), AnyPrefixMatcher(...)). It matches anything if matchers is empty.
+// Matchers share the bytes peeked as in AnyOf, and the first one not matching stops the others from being tried.
+func AllOf(matchers ...Matcher) Matcher {
+	return MatcherFunc(func(w io.Writer, r io.Reader) bool {
+		sniffReader := io_.SniffReader(r)
+		for _, pattern := range matchers {
+			sniffReader.Sniff(true)
+			ok := pattern.Match(w, sniffReader)
+			sniffReader.Sniff(false)
+			if !ok {
+				return false
+			}
+		}
+		return true
+	})
+}
+
 // AnyPrefixMatcher returns a matcher that matches a connection if it
 // starts with any of the strings in strs.
 func AnyPrefixMatcher(strs ...string) MatcherFunc {
@@ -7,6 +7,7 @@ package mux_test
 import (
 	"io"
 	"net"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -61,3 +62,50 @@ func test(t *testing.T, matcher mux.Matcher, payload string, mult int) {
 
 	wg.Wait()
 }
+
+func TestAnyOfAllOf(t *testing.T) {
+	const payload = "GET /version HTTP/1.1\r\n\r\n"
+
+	// recorder matches if it reads prefix at the start of the connection.
+	var peeked []string
+	recorder := func(prefix string) mux.Matcher {
+		return mux.MatcherFunc(func(_ io.Writer, r io.Reader) bool {
+			buf := make([]byte, len(prefix))
+			n, _ := io.ReadFull(r, buf)
+			peeked = append(peeked, string(buf[:n]))
+			return string(buf[:n]) == prefix
+		})
+	}
+
+	tests := []struct {
+		name       string
+		matcher    mux.Matcher
+		want       bool
+		wantPeeked []string
+	}{
+		{"AnyOf()", mux.AnyOf(), false, nil},
+		{"AllOf()", mux.AllOf(), true, nil},
+		{"AnyOf(HTTP2, HTTP1Fast)", mux.AnyOf(mux.HTTP2(), mux.HTTP1Fast()), true, nil},
+		{"AnyOf(TLS, HTTP2)", mux.AnyOf(mux.TLS(), mux.HTTP2()), false, nil},
+		{"AllOf(HTTP1Fast, GET)", mux.AllOf(mux.HTTP1Fast(), mux.AnyPrefixMatcher("GET /")), true, nil},
+		{"AllOf(HTTP1Fast, TLS)", mux.AllOf(mux.HTTP1Fast(), mux.TLS()), false, nil},
+		{"AllOf(AnyOf(TLS, HTTP1Fast), HTTP1)", mux.AllOf(mux.AnyOf(mux.TLS(), mux.HTTP1Fast()), mux.HTTP1()), true, nil},
+		{"AnyOf shares peeked bytes", mux.AnyOf(recorder("POST"), recorder("GET /v"), recorder("GET")),
+			true, []string{"GET ", "GET /v"}},
+		{"AllOf shares peeked bytes", mux.AllOf(recorder("GET"), recorder("GET /v"), recorder("PUT"), recorder("GET")),
+			false, []string{"GET", "GET /v", "GET"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			peeked = nil
+			// not a io.ReadSeeker, so that bytes are buffered as from a net.Conn
+			r := io.MultiReader(strings.NewReader(payload))
+			if got := tt.matcher.Match(io.Discard, r); got != tt.want {
+				t.Errorf("Match(%q): got %t, want %t", payload, got, tt.want)
+			}
+			if tt.wantPeeked != nil && !slices.Equal(peeked, tt.wantPeeked) {
+				t.Errorf("Match(%q): matchers peeked %q, want %q", payload, peeked, tt.wantPeeked)
+			}
+		})
+	}
+}
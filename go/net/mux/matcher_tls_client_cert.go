@@ -0,0 +1,47 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package mux
+
+import (
+	"crypto/tls"
+	"io"
+)
+
+// TLSClientCert matches connections terminated by TLS, as served by ServeTLS or by Serve on a listener
+// of tls.NewListener, by whether the client presented a certificate during the handshake,
+// such as to route mTLS clients and anonymous ones served on the same port apart.
+// The tls.Config must ask for client certificates, such as by ClientAuth of tls.RequestClientCert or
+// tls.VerifyClientCertIfGiven, or no client ever presents one.
+//
+// TLSClientCert completes the handshake, within the read timeout of ServeMux, and never matches
+// a connection not terminated by TLS, or whose handshake fails.
+//
+// Ordering: TLS sniffs the raw ClientHello, so it only applies to connections ServeMux does not terminate,
+// and never composes with TLSClientCert. SNI and ALPN are negotiated by the same handshake, so checks of them,
+// as of ConnectionState, can come before or after TLSClientCert alike; matchers after it,
+// such as in AllOf(TLSClientCert(true), HTTP1Fast()), read the decrypted application data.
+func TLSClientCert(required bool) MatcherFunc {
+	return func(w io.Writer, _ io.Reader) bool {
+		tlsConn := tlsConnOf(w)
+		if tlsConn == nil {
+			return false
+		}
+		if err := tlsConn.Handshake(); err != nil {
+			return false
+		}
+		return (len(tlsConn.ConnectionState().PeerCertificates) > 0) == required
+	}
+}
+
+// tlsConnOf returns the *tls.Conn w is or wraps, nil if none.
+func tlsConnOf(w io.Writer) *tls.Conn {
+	switch c := w.(type) {
+	case *tls.Conn:
+		return c
+	case *sniffConn:
+		return tlsConnOf(c.Conn)
+	}
+	return nil
+}
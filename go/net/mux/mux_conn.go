@@ -5,6 +5,7 @@
 package mux
 
 import (
+	"bytes"
 	"io"
 	"net"
 
@@ -15,12 +16,19 @@ import (
 type sniffConn struct {
 	net.Conn
 	sniffer io_.ReadSniffer
+	peeker  *peekRecorder
+
+	// dispatched is called once the connection is handed over to its handler
+	// or to Accept of the matched listener, nil if no backlog to release.
+	dispatched func()
 }
 
 func newMuxConn(c net.Conn) *sniffConn {
+	peeker := &peekRecorder{r: c}
 	return &sniffConn{
 		Conn:    c,
-		sniffer: io_.SniffReader(c),
+		sniffer: io_.SniffReader(peeker),
+		peeker:  peeker,
 	}
 }
 
@@ -46,3 +54,40 @@ func (m *sniffConn) startSniffing() io.Reader {
 func (m *sniffConn) doneSniffing() {
 	m.sniffer.Sniff(false)
 }
+
+// startPeeking starts recording the bytes read from the underlying connection.
+func (m *sniffConn) startPeeking() {
+	m.peeker.buf = &bytes.Buffer{}
+}
+
+// donePeeking stops recording and returns the bytes read since startPeeking.
+// The returned slice is owned by the caller, replays of the sniffer are unaffected by it.
+func (m *sniffConn) donePeeking() []byte {
+	if m.peeker.buf == nil {
+		return nil
+	}
+	peeked := m.peeker.buf.Bytes()
+	m.peeker.buf = nil
+	return peeked
+}
+
+// peekRecorder copies the bytes read from r into buf, if buf is not nil.
+type peekRecorder struct {
+	r   io.Reader
+	buf *bytes.Buffer
+}
+
+func (p *peekRecorder) Read(b []byte) (int, error) {
+	n, err := p.r.Read(b)
+	if p.buf != nil && n > 0 {
+		p.buf.Write(b[:n])
+	}
+	return n, err
+}
+
+// markDispatched marks c as handed over, see Server.SetAcceptBacklog.
+func markDispatched(c net.Conn) {
+	if c, ok := c.(*sniffConn); ok && c.dispatched != nil {
+		c.dispatched()
+	}
+}
@@ -60,6 +60,10 @@ type Server struct {
 	ctx        context.Context
 	inShutdown atomic.Bool // accessed atomically (non-zero means we're in Shutdown)
 
+	// acceptBacklog holds a token for each connection accepted but not dispatched yet,
+	// nil means no limit. See SetAcceptBacklog.
+	acceptBacklog chan struct{}
+
 	mu         sync.Mutex
 	listeners  map[*net.Listener]struct{}
 	activeConn map[*conn]struct{}
@@ -120,6 +124,46 @@ func (srv *Server) newConn(rwc net.Conn) *conn {
 	}
 }
 
+// SetAcceptBacklog bounds the number of connections accepted but not dispatched yet,
+// that is, connections being matched, or matched but not yet taken by Accept of the matched listener.
+// Once n connections are pending, Serve stops accepting new connections until one of them is dispatched,
+// so that a slow Accept caller applies backpressure instead of connections buffering unbounded.
+// Exceeding the backlog delays accepting at the OS level: new connections wait in the listen
+// queue of the OS, and may be refused or time out once it is full.
+// n <= 0 means no limit, which is the default.
+// SetAcceptBacklog must be called before Serve.
+func (srv *Server) SetAcceptBacklog(n int) {
+	if n <= 0 {
+		srv.acceptBacklog = nil
+		return
+	}
+	srv.acceptBacklog = make(chan struct{}, n)
+}
+
+// acquireBacklog blocks until a connection can be accepted within the backlog,
+// and reports whether the server is still up.
+func (srv *Server) acquireBacklog(ctx context.Context, backlog chan struct{}) bool {
+	if backlog == nil {
+		return true
+	}
+	select {
+	case backlog <- struct{}{}:
+		return true
+	case <-ctx.Done():
+		return false
+	case <-srv.getDoneChan():
+		return false
+	}
+}
+
+// releaseBacklog returns the token acquired by acquireBacklog.
+func releaseBacklog(backlog chan struct{}) {
+	if backlog == nil {
+		return
+	}
+	<-backlog
+}
+
 // Serve starts multiplexing the listener. Serve blocks and perhaps
 // should be invoked concurrently within a go routine.
 // Serve accepts incoming connections on the ServeMux l, creating a
@@ -140,10 +184,15 @@ func (srv *Server) Serve(l net.Listener) error {
 
 	var tempDelay time.Duration // how long to sleep on accept failure
 	ctx := context.WithValue(srv.Context(), ServerContextKey, srv)
+	backlog := srv.acceptBacklog
 
 	for {
+		if !srv.acquireBacklog(ctx, backlog) {
+			return ErrServerClosed
+		}
 		rw, err := l.Accept()
 		if err != nil {
+			releaseBacklog(backlog)
 			select {
 			case <-ctx.Done():
 				return ErrServerClosed
@@ -173,6 +222,9 @@ func (srv *Server) Serve(l net.Listener) error {
 		tempDelay = 0
 
 		c := srv.newConn(rw)
+		if backlog != nil {
+			c.muc.dispatched = sync.OnceFunc(func() { releaseBacklog(backlog) })
+		}
 		c.setState(c.muc, ConnStateNew) // before Serve can return
 
 		go c.serve(ctx)
@@ -5,13 +5,13 @@
 package mux
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net"
 	"sync"
 	"time"
 
-	io_ "github.com/searKing/golang/go/io"
 	net_ "github.com/searKing/golang/go/net"
 )
 
@@ -30,6 +30,7 @@ type ServeMux struct {
 	// NotFound replies to the listener with a not found error.
 	NotFoundHandler HandlerConn
 	sniffTimeout    time.Duration
+	sniffHook       func(conn net.Conn, peeked []byte)
 
 	mu sync.RWMutex
 	m  []muxEntry
@@ -44,11 +45,17 @@ type muxEntry struct {
 
 func (e muxEntry) Serve(c net.Conn) {
 	if e.h != nil {
+		markDispatched(c)
 		e.h.Serve(c)
 		return
 	}
 	if e.l != nil {
-		e.l.C <- c
+		select {
+		case e.l.C <- c:
+			markDispatched(c)
+		case <-e.l.DoneC():
+			_ = c.Close()
+		}
 		return
 	}
 	panic("mux_entry: nil handler")
@@ -65,19 +72,9 @@ func (f MatcherFunc) Match(w io.Writer, r io.Reader) bool {
 	return f(w, r)
 }
 
+// MatcherAny is the same as AnyOf.
 func MatcherAny(matchers ...Matcher) Matcher {
-	return MatcherFunc(func(w io.Writer, r io.Reader) bool {
-		sniffReader := io_.SniffReader(r)
-		for _, pattern := range matchers {
-			sniffReader.Sniff(true)
-			if pattern.Match(w, sniffReader) {
-				sniffReader.Sniff(false)
-				return true
-			}
-			sniffReader.Sniff(false)
-		}
-		return false
-	})
+	return AnyOf(matchers...)
 }
 
 // NewServeMux allocates and returns a new ServeMux.
@@ -97,6 +94,28 @@ func (mux *ServeMux) SetReadTimeout(t time.Duration) {
 	mux.sniffTimeout = t
 }
 
+// SetSniffHook sets a diagnostic hook, called once per connection with a copy of the bytes
+// read by matchers, before the connection is handed off to the matched handler or listener.
+// The hook is called whether a matcher matched or not, peeked is nil if nothing was read.
+// While set, every byte read during matching is copied into a buffer per connection,
+// and the hook runs on the serving goroutine, delaying the hand-off until it returns.
+// A nil hook disables it.
+func (mux *ServeMux) SetSniffHook(hook func(conn net.Conn, peeked []byte)) {
+	mux.sniffHook = hook
+}
+
+// Listener is implemented by the net.Listener of the connections matched by a pattern, as returned by HandleListener,
+// so that AcceptContext is called by a type assertion, such as l.(mux.Listener).AcceptContext(ctx).
+type Listener interface {
+	net.Listener
+
+	// AcceptContext waits for and returns the next connection matched, as Accept does,
+	// but stops waiting once ctx is done, returning ctx.Err(), such as on a graceful shutdown.
+	// A connection matched while no one is accepting keeps waiting for the next Accept, it is never dropped.
+	AcceptContext(ctx context.Context) (net.Conn, error)
+}
+
+// HandleListener returns a net.Listener of the connections matched by pattern, implementing Listener too.
 func (mux *ServeMux) HandleListener(pattern Matcher) net.Listener {
 	mux.mu.Lock()
 	defer mux.mu.Unlock()
@@ -181,7 +200,14 @@ func (mux *ServeMux) Serve(c net.Conn) {
 		muxC = newMuxConn(c)
 	}
 
+	hook := mux.sniffHook
+	if hook != nil {
+		muxC.startPeeking()
+	}
 	h := mux.Handler(muxC)
+	if hook != nil {
+		hook(c, muxC.donePeeking())
+	}
 	h.Serve(c)
 }
 
@@ -195,6 +221,8 @@ func (mux *ServeMux) Close() error {
 	return errors.Join(errs...)
 }
 
+// HandleListener returns a net.Listener of the connections matched by pattern in the DefaultServeMux,
+// implementing Listener too.
 func HandleListener(pattern Matcher) net.Listener {
 	return DefaultServeMux.HandleListener(pattern)
 }
@@ -210,3 +238,8 @@ func HandleFunc(pattern Matcher, handler func(net.Conn)) {
 func SetReadTimeout(t time.Duration) {
 	DefaultServeMux.SetReadTimeout(t)
 }
+
+// SetSniffHook sets a diagnostic hook of the DefaultServeMux, see ServeMux.SetSniffHook.
+func SetSniffHook(hook func(conn net.Conn, peeked []byte)) {
+	DefaultServeMux.SetSniffHook(hook)
+}
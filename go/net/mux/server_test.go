@@ -6,6 +6,7 @@ package mux_test
 
 import (
 	"context"
+	"crypto/tls"
 	"io"
 	"log"
 	"net"
@@ -281,6 +282,89 @@ func TestTLS(t *testing.T) {
 	runTestTLSClient(t, l.Addr())
 }
 
+func TestTLSClientCert(t *testing.T) {
+	generateTLSCert(t)
+	defer cleanupTLSCert(t)
+	defer leakcheck.Check(t)
+	errCh := make(chan error, 1)
+	const payload = "ping"
+
+	certificate, err := tls.LoadX509KeyPair("cert.pem", "key.pem")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := testListener(t)
+	defer l.Close()
+	tlsl := tls.NewListener(l, &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequestClientCert,
+	})
+
+	muxer := mux.NewServeMux()
+	mtlsl := muxer.HandleListener(mux.TLSClientCert(true))
+	publicl := muxer.HandleListener(mux.TLSClientCert(false))
+
+	srv := mux.NewServer()
+	defer srv.Close()
+	srv.Handler = muxer
+	go safeServe(errCh, srv, tlsl)
+
+	acceptedCh := make(chan string, 2)
+	accept := func(name string, l net.Listener) {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			var b [len(payload)]byte
+			if _, err := io.ReadFull(conn, b[:]); err != nil || string(b[:]) != payload {
+				acceptedCh <- name + " read " + string(b[:])
+			} else {
+				acceptedCh <- name
+			}
+			_ = conn.Close()
+		}
+	}
+	go accept("mtls", mtlsl)
+	go accept("public", publicl)
+
+	for _, tt := range []struct {
+		name         string
+		certificates []tls.Certificate
+		want         string
+	}{
+		{name: "with client cert", certificates: []tls.Certificate{certificate}, want: "mtls"},
+		{name: "without client cert", want: "public"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			client, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{
+				Certificates:       tt.certificates,
+				InsecureSkipVerify: true,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer client.Close()
+			if _, err := io.WriteString(client, payload); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case got := <-acceptedCh:
+				if got != tt.want {
+					t.Errorf("accepted by %q, want %q", got, tt.want)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("not accepted, want by %q", tt.want)
+			}
+		})
+	}
+	select {
+	case err := <-errCh:
+		t.Fatal(err)
+	default:
+	}
+}
+
 func TestHTTP2(t *testing.T) {
 	defer leakcheck.Check(t)
 	errCh := make(chan error)
@@ -537,3 +621,195 @@ func TestClose(t *testing.T) {
 		}
 	}
 }
+
+func TestAcceptBacklog(t *testing.T) {
+	defer leakcheck.Check(t)
+	errCh := make(chan error, 1)
+	l := newChanListener()
+	defer l.Close()
+
+	muxer := mux.NewServeMux()
+	anyl := muxer.HandleListener(mux.Any())
+
+	var accepted atomic.Int32
+	srv := mux.NewServer()
+	srv.Handler = muxer
+	srv.ConnStateHook = func(_ net.Conn, state mux.ConnState) {
+		if state == mux.ConnStateNew {
+			accepted.Add(1)
+		}
+	}
+	const backlog = 2
+	srv.SetAcceptBacklog(backlog)
+	go safeServe(errCh, srv, l)
+
+	var peers []net.Conn
+	defer func() {
+		for _, c := range peers {
+			_ = c.Close()
+		}
+	}()
+	for i := 0; i < backlog+1; i++ {
+		c1, c2 := net.Pipe()
+		peers = append(peers, c2)
+		l.Notify(c1)
+	}
+
+	waitAccepted := func(want int32) {
+		t.Helper()
+		deadline := time.Now().Add(time.Second)
+		for accepted.Load() < want && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		// give Serve the chance to accept more than want
+		time.Sleep(50 * time.Millisecond)
+		if got := accepted.Load(); got != want {
+			t.Fatalf("accepted %d connections, want %d", got, want)
+		}
+	}
+	// nobody calls anyl.Accept, so that the backlog is full and the last connection keeps waiting in l.
+	waitAccepted(backlog)
+
+	// dispatching one connection makes room for the waiting one
+	c, err := anyl.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	waitAccepted(backlog + 1)
+
+	if err := srv.Close(); err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case err := <-errCh:
+		t.Fatal(err)
+	default:
+	}
+}
+
+func TestSniffHook(t *testing.T) {
+	defer leakcheck.Check(t)
+	errCh := make(chan error, 1)
+	const payload = "hello world\r\n"
+	const sniffed = "hello"
+
+	writer, reader := net.Pipe()
+	go func() {
+		if _, err := io.WriteString(writer, payload); err != nil {
+			errCh <- err
+		}
+		_ = writer.Close()
+	}()
+
+	muxer := mux.NewServeMux()
+	_ = muxer.HandleListener(mux.MatcherFunc(func(w io.Writer, r io.Reader) bool {
+		var b [len(sniffed)]byte
+		_, _ = io.ReadFull(r, b[:])
+		return false
+	}))
+	anyl := muxer.HandleListener(mux.Any())
+
+	var hooked atomic.Int32
+	peekedCh := make(chan string, 1)
+	muxer.SetSniffHook(func(_ net.Conn, peeked []byte) {
+		hooked.Add(1)
+		peekedCh <- string(peeked)
+		// the hook owns its copy, the replayed stream must stay intact
+		for i := range peeked {
+			peeked[i] = 'x'
+		}
+	})
+
+	l := newChanListener()
+	l.Notify(reader)
+	defer l.Close()
+	srv := mux.NewServer()
+	defer srv.Close()
+	srv.Handler = muxer
+	go safeServe(errCh, srv, l)
+
+	muxedConn, err := anyl.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer muxedConn.Close()
+	if got := <-peekedCh; got != sniffed {
+		t.Errorf("peeked %q, want %q", got, sniffed)
+	}
+	b, err := io.ReadAll(muxedConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(b); got != payload {
+		t.Errorf("read %q, want %q", got, payload)
+	}
+	if got := hooked.Load(); got != 1 {
+		t.Errorf("hook called %d times, want 1", got)
+	}
+	select {
+	case err := <-errCh:
+		t.Fatal(err)
+	default:
+	}
+}
+
+func TestAcceptContext(t *testing.T) {
+	defer leakcheck.Check(t)
+	errCh := make(chan error, 1)
+	l := newChanListener()
+	defer l.Close()
+
+	muxer := mux.NewServeMux()
+	anyl, ok := muxer.HandleListener(mux.Any()).(mux.Listener)
+	if !ok {
+		t.Fatal("HandleListener: got no mux.Listener")
+	}
+	srv := mux.NewServer()
+	defer srv.Close()
+	srv.Handler = muxer
+	go safeServe(errCh, srv, l)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	acceptErr := make(chan error, 1)
+	go func() {
+		c, err := anyl.AcceptContext(ctx)
+		if c != nil {
+			_ = c.Close()
+		}
+		acceptErr <- err
+	}()
+	// let AcceptContext block, as no connection is coming
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+	select {
+	case err := <-acceptErr:
+		if err != context.Canceled {
+			t.Fatalf("AcceptContext after cancel: got %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("AcceptContext is still blocked after cancel")
+	}
+
+	// a connection matched after the cancellation waits for the next Accept
+	c1, c2 := net.Pipe()
+	defer c2.Close()
+	l.Notify(c1)
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	c, err := anyl.AcceptContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = c.Close()
+
+	_ = anyl.Close()
+	if _, err := anyl.AcceptContext(context.Background()); err != mux.ErrListenerClosed {
+		t.Fatalf("AcceptContext after Close: got %v, want %v", err, mux.ErrListenerClosed)
+	}
+	select {
+	case err := <-errCh:
+		t.Fatal(err)
+	default:
+	}
+}
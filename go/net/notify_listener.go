@@ -5,6 +5,7 @@
 package net
 
 import (
+	"context"
 	"net"
 	"sync"
 )
@@ -40,7 +41,16 @@ func (l *NotifyListener) Addr() net.Addr {
 }
 
 func (l *NotifyListener) Accept() (net.Conn, error) {
+	return l.AcceptContext(context.Background())
+}
+
+// AcceptContext waits for and returns the next connection to the listener, as Accept does,
+// but stops waiting once ctx is done, returning ctx.Err(), such as on a graceful shutdown.
+// A connection is never lost by a cancellation: it stays queued for the next Accept.
+func (l *NotifyListener) AcceptContext(ctx context.Context) (net.Conn, error) {
 	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
 	case <-l.getDoneChan():
 		return nil, ErrListenerClosed
 	case c, ok := <-l.C:
@@ -0,0 +1,28 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rate
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// Go waits for a token, then calls f in a new goroutine of g,
+// and puts the token back when f returns, so that at most Burst calls of f run at once.
+// Errors returned by f propagate via g as usual.
+//
+// Go blocks the caller until a token is available or ctx is done.
+// If ctx is done first, f is not called and ctx.Err() is reported to g in place of the error of f.
+func (lim *BurstLimiter) Go(ctx context.Context, g *errgroup.Group, f func() error) {
+	if err := lim.Wait(ctx); err != nil {
+		g.Go(func() error { return err })
+		return
+	}
+	g.Go(func() error {
+		defer lim.PutToken()
+		return f()
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -22,6 +23,9 @@ type expectKeyType struct{}
 
 var expectTokensKey expectKeyType
 
+// arrivalEpoch is the origin of the monotonic times of admission attempts recorded by WithArrivalRecorder.
+var arrivalEpoch = time.Now()
+
 // A BurstLimiter controls how frequently events are allowed to happen.
 // It implements a "token bucket" of size b, initially full and refilled
 // by PutToken or PutTokenN.
@@ -63,6 +67,15 @@ type BurstLimiter struct {
 	tokensChangedListeners []context.Context
 
 	tokens int // unconsumed tokens
+
+	// hysteresis band of admission, see WithWatermarks, no band if highWatermark is 0
+	lowWatermark, highWatermark int
+	closed                      bool // tokens dropped below lowWatermark, and have not reached highWatermark since
+
+	// called with the gap since the admission attempt before by Allow and Wait, see WithArrivalRecorder
+	// loaded without lim.mu, so that admissions not recorded cost no extra lock
+	recordArrival atomic.Pointer[func(gap time.Duration)]
+	lastArrival   atomic.Int64 // time since arrivalEpoch of the last admission attempt, 0 if none
 }
 
 // Burst returns the maximum burst size. Burst is the maximum number of tokens
@@ -84,19 +97,21 @@ func (lim *BurstLimiter) Tokens() int {
 
 // NewFullBurstLimiter returns a new BurstLimiter with full tokens that allows
 // events up to burst b and permits bursts of at most b tokens.
-func NewFullBurstLimiter(b int) *BurstLimiter {
-	return &BurstLimiter{
+func NewFullBurstLimiter(b int, opts ...BurstLimiterOption) *BurstLimiter {
+	lim := &BurstLimiter{
 		burst:  b,
 		tokens: b,
 	}
+	return lim.ApplyOptions(opts...)
 }
 
 // NewEmptyBurstLimiter returns a new BurstLimiter with zero tokens that allows
 // events up to burst b and permits bursts of at most b tokens.
-func NewEmptyBurstLimiter(b int) *BurstLimiter {
-	return &BurstLimiter{
+func NewEmptyBurstLimiter(b int, opts ...BurstLimiterOption) *BurstLimiter {
+	lim := &BurstLimiter{
 		burst: b,
 	}
+	return lim.ApplyOptions(opts...)
 }
 
 // NewReorderBuffer returns a new BurstLimiter with exactly only one token that allows
@@ -130,9 +145,24 @@ func (lim *BurstLimiter) Allow() bool {
 // Otherwise, use Reserve or Wait.
 // 当没有可用或足够的事件时，返回false
 func (lim *BurstLimiter) AllowN(n int) bool {
+	lim.recordArrivalGap()
 	return lim.GetTokenN(n)
 }
 
+// AllowWithRefund is like Allow, and also returns refund, which puts the token got back
+// if the event allowed turns out not to consume what the token stands for, such as an operation failing fast.
+// It is like Reserve and Cancel, but for the non-blocking path.
+// refund is a no-op if ok is false, and puts the token back once at most, however many times it is called.
+// As PutToken, refund never fills the bucket beyond burst, so refunding a token that was put back
+// already in the meantime is still safe.
+func (lim *BurstLimiter) AllowWithRefund() (ok bool, refund func()) {
+	if !lim.Allow() {
+		return false, func() {}
+	}
+	var once sync.Once
+	return true, func() { once.Do(lim.PutToken) }
+}
+
 // Reserve is shorthand for ReserveN(1).
 // 当没有可用或足够的事件时，返回 Reservation，和要等待多久才能获得足够的事件。
 func (lim *BurstLimiter) Reserve(ctx context.Context) *Reservation {
@@ -187,6 +217,7 @@ func (lim *BurstLimiter) Wait(ctx context.Context) (err error) {
 // canceled, or the expected wait time exceeds the Context's Deadline.
 // The burst limit is ignored if the rate limit is Inf.
 func (lim *BurstLimiter) WaitN(ctx context.Context, n int) (err error) {
+	lim.recordArrivalGap()
 	lim.mu.Lock()
 	burst := lim.burst
 	lim.mu.Unlock()
@@ -223,8 +254,28 @@ func (lim *BurstLimiter) PutTokenN(n int) {
 	if lim.tokens > lim.burst {
 		lim.tokens = lim.burst
 	}
+	lim.notifyTokensChangedLocked()
+}
+
+// SetTokens sets the tokens unconsumed to n, clamped to [0, burst], and hands the tokens over to
+// the waiters of Wait and Reserve in order, as PutTokenN does, if any.
+// It is primarily for testing and for restoring a state saved before, such as across restarts;
+// tokens taken by Allow, Reserve or Wait concurrently are overwritten, not accounted.
+func (lim *BurstLimiter) SetTokens(n int) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.tokens = max(min(n, lim.burst), 0)
+	lim.notifyTokensChangedLocked()
+}
 
+// notifyTokensChangedLocked hands the tokens unconsumed over to the waiters in order, while lim is open.
+// notifyTokensChangedLocked requires that lim.mu is held.
+func (lim *BurstLimiter) notifyTokensChangedLocked() {
+	defer lim.updateWatermarkLocked()
 	for i := 0; i < len(lim.tokensChangedListeners); i++ {
+		if lim.updateWatermarkLocked(); lim.closed {
+			break
+		}
 		tokensGot := lim.tokensChangedListeners[i]
 		r := tokensGot.Value(expectTokensKey).(*reservation)
 		if r.burst <= 0 {
@@ -281,13 +332,45 @@ func (lim *BurstLimiter) getTokenNLocked(n int) (ok bool) {
 	if n <= 0 {
 		return true
 	}
+	if lim.closed {
+		return false
+	}
 	if lim.tokens >= n {
 		lim.tokens -= n
+		lim.updateWatermarkLocked()
 		return true
 	}
 	return false
 }
 
+// updateWatermarkLocked closes lim once its tokens drop below the low watermark,
+// and opens it once they reach the high one, if WithWatermarks set a band.
+// updateWatermarkLocked requires that lim.mu is held.
+func (lim *BurstLimiter) updateWatermarkLocked() {
+	if lim.highWatermark <= 0 {
+		return
+	}
+	switch {
+	case lim.tokens < lim.lowWatermark:
+		lim.closed = true
+	case lim.tokens >= lim.highWatermark:
+		lim.closed = false
+	}
+}
+
+// recordArrivalGap calls the recorder of WithArrivalRecorder, if any, with the gap since the admission attempt before,
+// unless this attempt is the first one.
+func (lim *BurstLimiter) recordArrivalGap() {
+	record := lim.recordArrival.Load()
+	if record == nil {
+		return
+	}
+	now := int64(time.Since(arrivalEpoch))
+	if last := lim.lastArrival.Swap(now); last != 0 {
+		(*record)(time.Duration(now - last))
+	}
+}
+
 // reserveN is a helper method for AllowN, ReserveN, and WaitN.
 // maxFutureReserve specifies the maximum reservation wait duration allowed.
 // reserveN returns Reservation, not *reservation, to avoid allocation in AllowN and WaitN.
@@ -0,0 +1,73 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package rate
+
+import (
+	"fmt"
+	"time"
+)
+
+// A BurstLimiterOption sets options.
+type BurstLimiterOption interface {
+	apply(*BurstLimiter)
+}
+
+// BurstLimiterOptionFunc wraps a function that modifies BurstLimiter into an
+// implementation of the BurstLimiterOption interface.
+type BurstLimiterOptionFunc func(*BurstLimiter)
+
+func (f BurstLimiterOptionFunc) apply(do *BurstLimiter) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one,
+// holding the lock of lim, and hands the tokens over to the waiters the options admit, if any.
+func (lim *BurstLimiter) ApplyOptions(options ...BurstLimiterOption) *BurstLimiter {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(lim)
+	}
+	lim.notifyTokensChangedLocked()
+	return lim
+}
+
+// WithWatermarks makes the limiter admit events with hysteresis, such as when toggling admission is expensive
+// downstream, so that it does not flap on and off around a single threshold of tokens:
+//   - open: events are admitted as tokens allow, until the tokens unconsumed drop below low, which closes it;
+//   - closed: no event is admitted, by Allow, Reserve or Wait, whatever the tokens, until the tokens put back
+//     reach high, which opens it again.
+//
+// The limiter starts closed if its tokens are below low, open otherwise.
+// Waiters are handed tokens only while the limiter is open, and in order.
+// high greater than the burst never reopens a limiter once closed, as tokens never exceed the burst.
+// WithWatermarks(0, 0) removes the band. WithWatermarks panics if low is negative or greater than high.
+func WithWatermarks(low, high int) BurstLimiterOption {
+	if low < 0 || low > high {
+		panic(fmt.Sprintf("rate: invalid watermarks [%d, %d]", low, high))
+	}
+	return BurstLimiterOptionFunc(func(lim *BurstLimiter) {
+		lim.lowWatermark, lim.highWatermark = low, high
+		lim.closed = lim.tokens < low
+	})
+}
+
+// WithArrivalRecorder makes the limiter call record on each admission attempt by Allow, AllowN, Wait and WaitN,
+// with the time since the attempt before, such as to build a histogram of inter-arrival gaps to tune the burst.
+// The first attempt has no attempt before, and is not recorded.
+// record runs inline, before the attempt, on the goroutine attempting, so it should be cheap,
+// and must not attempt admissions on the limiter itself. A nil record stops recording.
+func WithArrivalRecorder(record func(gap time.Duration)) BurstLimiterOption {
+	return BurstLimiterOptionFunc(func(lim *BurstLimiter) {
+		if record == nil {
+			lim.recordArrival.Store(nil)
+			return
+		}
+		lim.recordArrival.Store(&record)
+	})
+}
@@ -6,6 +6,7 @@ package rate
 
 import (
 	"context"
+	"errors"
 	"runtime"
 	"runtime/debug"
 	"sync"
@@ -14,6 +15,7 @@ import (
 	"time"
 
 	time_ "github.com/searKing/golang/go/time"
+	"golang.org/x/sync/errgroup"
 )
 
 const (
@@ -415,3 +417,258 @@ func TestSimultaneousLongRequests(t *testing.T) {
 		t.Errorf("numOK = %d, want %d", numOK, numRequests)
 	}
 }
+
+func TestBurstLimiterGo(t *testing.T) {
+	const burst = 3
+	const tasks = 20
+	lim := NewFullBurstLimiter(burst)
+	var g errgroup.Group
+
+	var running, maxRunning atomic.Int32
+	for i := 0; i < tasks; i++ {
+		lim.Go(context.Background(), &g, func() error {
+			n := running.Add(1)
+			defer running.Add(-1)
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatalf("g.Wait() = %v, want nil", err)
+	}
+	if got := maxRunning.Load(); got > burst {
+		t.Errorf("ran %d tasks at once, want at most %d", got, burst)
+	}
+	if got := lim.Tokens(); got != burst {
+		t.Errorf("lim.Tokens() = %d after all tasks, want %d", got, burst)
+	}
+
+	// errors of f propagate via the group
+	errTask := errors.New("task failed")
+	g = errgroup.Group{}
+	lim.Go(context.Background(), &g, func() error { return errTask })
+	if err := g.Wait(); err != errTask {
+		t.Errorf("g.Wait() = %v, want %v", err, errTask)
+	}
+
+	// a done ctx is reported to the group, and f is not called
+	empty := NewEmptyBurstLimiter(1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var called atomic.Bool
+	g = errgroup.Group{}
+	empty.Go(ctx, &g, func() error { called.Store(true); return nil })
+	if err := g.Wait(); err != context.DeadlineExceeded {
+		t.Errorf("g.Wait() = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if called.Load() {
+		t.Errorf("f called without a token")
+	}
+}
+
+func TestAllowWithRefund(t *testing.T) {
+	lim := NewFullBurstLimiter(1)
+	ok, refund := lim.AllowWithRefund()
+	if !ok {
+		t.Fatalf("AllowWithRefund on a full limiter: got false, want true")
+	}
+	if ok, refundNone := lim.AllowWithRefund(); ok {
+		t.Fatalf("AllowWithRefund on an empty limiter: got true, want false")
+	} else {
+		refundNone()
+		if got := lim.Tokens(); got != 0 {
+			t.Errorf("refund of a token not got: got %d tokens, want 0", got)
+		}
+	}
+
+	// refund wakes up the waiters for tokens
+	done := make(chan error, 1)
+	go func() { done <- lim.Wait(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	refund()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Wait: not woken up by refund")
+	}
+
+	// refund puts the token back once at most, and never beyond burst
+	refund()
+	if got := lim.Tokens(); got != 0 {
+		t.Errorf("refund twice: got %d tokens, want 0", got)
+	}
+	ok, refund = lim.AllowWithRefund()
+	if ok {
+		t.Fatalf("AllowWithRefund: got true, want false with the token taken by Wait")
+	}
+	lim.PutToken()
+	ok, refund = lim.AllowWithRefund()
+	if !ok {
+		t.Fatalf("AllowWithRefund: got false, want true")
+	}
+	lim.PutToken()
+	refund()
+	if got := lim.Tokens(); got != lim.Burst() {
+		t.Errorf("refund after the token was put back: got %d tokens, want %d", got, lim.Burst())
+	}
+}
+
+func TestArrivalRecorder(t *testing.T) {
+	var gaps []time.Duration
+	lim := NewFullBurstLimiter(1, WithArrivalRecorder(func(gap time.Duration) { gaps = append(gaps, gap) }))
+
+	sleeps := []time.Duration{10 * time.Millisecond, 50 * time.Millisecond, 20 * time.Millisecond}
+	lim.Allow()
+	if len(gaps) != 0 {
+		t.Errorf("Allow first: got gaps %v recorded, want none", gaps)
+	}
+	for i, d := range sleeps {
+		time.Sleep(d)
+		if i%2 == 0 {
+			lim.Allow()
+		} else {
+			lim.PutToken()
+			if err := lim.Wait(context.Background()); err != nil {
+				t.Fatalf("Wait: %v", err)
+			}
+		}
+	}
+	if len(gaps) != len(sleeps) {
+		t.Fatalf("got %d gaps recorded, want %d", len(gaps), len(sleeps))
+	}
+	for i, d := range sleeps {
+		if gaps[i] < d || gaps[i] > d+40*time.Millisecond {
+			t.Errorf("gap #%d: got %v, want about %v", i, gaps[i], d)
+		}
+	}
+
+	lim.ApplyOptions(WithArrivalRecorder(nil))
+	lim.Allow()
+	if len(gaps) != len(sleeps) {
+		t.Errorf("Allow with the recorder removed: got %d gaps recorded, want %d", len(gaps), len(sleeps))
+	}
+}
+
+func TestSetTokens(t *testing.T) {
+	lim := NewFullBurstLimiter(3)
+	if !lim.AllowN(2) {
+		t.Fatalf("AllowN(2) on a full limiter: got false, want true")
+	}
+
+	// tokens set mid-flight replace the tokens unconsumed, clamped to [0, burst]
+	for _, tt := range []struct {
+		n    int
+		want int
+	}{{2, 2}, {0, 0}, {5, 3}, {-1, 0}} {
+		lim.SetTokens(tt.n)
+		if got := lim.Tokens(); got != tt.want {
+			t.Errorf("SetTokens(%d): got %d tokens, want %d", tt.n, got, tt.want)
+		}
+		var allowed int
+		for lim.Allow() {
+			allowed++
+		}
+		if allowed != tt.want {
+			t.Errorf("SetTokens(%d): Allow succeeded %d times, want %d", tt.n, allowed, tt.want)
+		}
+	}
+
+	// tokens set wake up the waiters for tokens
+	done := make(chan error, 1)
+	go func() { done <- lim.WaitN(context.Background(), 2) }()
+	time.Sleep(10 * time.Millisecond)
+	lim.SetTokens(3)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("WaitN: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("WaitN: not woken up by SetTokens")
+	}
+	if got := lim.Tokens(); got != 1 {
+		t.Errorf("SetTokens with a waiter: got %d tokens, want 1 left", got)
+	}
+}
+
+func TestWatermarks(t *testing.T) {
+	lim := NewFullBurstLimiter(10, WithWatermarks(3, 7))
+	allowed := func() int {
+		var n int
+		for lim.Allow() {
+			n++
+		}
+		return n
+	}
+
+	// open: admitted until tokens drop below low
+	if got := allowed(); got != 8 {
+		t.Errorf("Allow on an open limiter: succeeded %d times, want 8", got)
+	}
+	if got := lim.Tokens(); got != 2 {
+		t.Errorf("Tokens on a limiter closed: got %d, want 2", got)
+	}
+
+	// closed: not admitted until tokens reach high, even with tokens enough
+	lim.PutTokenN(4)
+	if lim.Allow() {
+		t.Errorf("Allow on a closed limiter with 6 tokens: got true, want false")
+	}
+	done := make(chan error, 1)
+	go func() { done <- lim.Wait(context.Background()) }()
+	time.Sleep(10 * time.Millisecond)
+	select {
+	case err := <-done:
+		t.Fatalf("Wait on a closed limiter: got %v, want blocked", err)
+	default:
+	}
+	lim.PutToken()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Wait: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("Wait: not woken up by tokens reaching high")
+	}
+
+	// open again, down to below low
+	if got := allowed(); got != 4 {
+		t.Errorf("Allow on a limiter reopened with 6 tokens: succeeded %d times, want 4", got)
+	}
+
+	// starts closed below low, and stays closed in the band
+	lim = NewEmptyBurstLimiter(10, WithWatermarks(3, 7))
+	lim.SetTokens(5)
+	if lim.Allow() {
+		t.Errorf("Allow on a limiter started empty with 5 tokens: got true, want false")
+	}
+	lim.SetTokens(7)
+	if !lim.Allow() {
+		t.Errorf("Allow on a limiter with tokens set to high: got false, want true")
+	}
+
+	// removing the band admits as tokens allow
+	lim = NewFullBurstLimiter(10, WithWatermarks(3, 7))
+	allowed()
+	lim.ApplyOptions(WithWatermarks(0, 0))
+	if got := allowed(); got != 2 {
+		t.Errorf("Allow with the band removed: succeeded %d times, want 2", got)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("WithWatermarks(7, 3): got no panic")
+		}
+	}()
+	WithWatermarks(7, 3)
+}
@@ -7,6 +7,7 @@ package structpb
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
 
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
@@ -15,6 +16,12 @@ import (
 
 // ToProtoStruct converts v, which must marshal into a JSON object,
 // into a Google Struct proto.
+// Timestamp and Any held by v are converted in their canonical JSON mapping, as protojson does,
+// a Timestamp into an RFC 3339 string and an Any into an object with its "@type".
+// Errors held by v are converted into the strings of their messages, as ToProtoStructReflect does,
+// instead of the objects of their fields, mostly {}.
+// v is converted by encoding/json unless its type holds one of them,
+// or, behind an interface such as any, its value does.
 func ToProtoStruct(v any) (*structpb.Struct, error) {
 	if v == nil {
 		return &structpb.Struct{}, nil
@@ -47,6 +54,15 @@ func ToProtoStruct(v any) (*structpb.Struct, error) {
 		}
 		jb = dataStr
 	default:
+		// encoding/json marshals Timestamp, Any and errors as plain structs
+		switch wellKnownKindOf(reflect.TypeOf(v)) {
+		case wellKnownAlways:
+			return ToProtoStructReflect(v)
+		case wellKnownMaybe:
+			if holdsWellKnownValue(reflect.ValueOf(v), 0) {
+				return ToProtoStructReflect(v)
+			}
+		}
 		var err error
 		jb, err = json.Marshal(v)
 		if err != nil {
@@ -61,3 +77,20 @@ func ToProtoStruct(v any) (*structpb.Struct, error) {
 	}
 	return &dataStructpb, nil
 }
+
+// ToProtoStructSafe converts v into a Google Struct proto, as ToProtoStruct does,
+// but returns a panic raised during the conversion as an error instead,
+// so that untrusted input cannot crash the process.
+func ToProtoStructSafe(v any) (s *structpb.Struct, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s = nil
+			if e, ok := r.(error); ok {
+				err = fmt.Errorf("structpb: panic converting %T: %w", v, e)
+				return
+			}
+			err = fmt.Errorf("structpb: panic converting %T: %v", v, r)
+		}
+	}()
+	return ToProtoStruct(v)
+}
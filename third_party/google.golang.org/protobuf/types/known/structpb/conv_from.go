@@ -0,0 +1,138 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package structpb
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// FromProtoStruct converts the Google Struct proto s into out, which must be a non-nil pointer,
+// the reverse of ToProtoStruct.
+// s is decoded into out by encoding/json, or by protojson if out is a proto.Message.
+// Unlike encoding/json, which decodes all numbers held by interface values as float64,
+// numbers held by interface values, such as in a map[string]any, are decoded as int64 if they are integers
+// fitting into an int64, and as float64 otherwise.
+func FromProtoStruct(s *structpb.Struct, out any) error {
+	if s == nil {
+		s = &structpb.Struct{}
+	}
+	jb, err := protojson.Marshal(s)
+	if err != nil {
+		return fmt.Errorf("jsonpb.Marshal: %v", err)
+	}
+	if m, ok := out.(proto.Message); ok {
+		return protojson.Unmarshal(jb, m)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(jb))
+	dec.UseNumber()
+	if err := dec.Decode(out); err != nil {
+		return err
+	}
+	narrowNumbers(reflect.ValueOf(out), 0)
+	return nil
+}
+
+// RoundTrip converts v into a Google Struct proto by ToProtoStruct, then back into out by FromProtoStruct,
+// such as to test that a type survives being carried in a Struct, with out a pointer to a value of the type of v.
+//
+// A Struct holds JSON values, so the types of v round-trip as follows:
+//
+//	type of v                           out after RoundTrip
+//	bool, string                        lossless
+//	int8 to int32, uint8 to uint32      lossless
+//	int, int64, uint, uint64            lossless within ±2^53, rounded to the nearest float64 beyond,
+//	                                    an error if rounded out of the range of the type, such as math.MaxUint64
+//	float64, float32                    lossless, an error for NaN and ±Inf
+//	[]byte                              lossless, as a base64 string
+//	[]T{}, map[K]V{}                    empty, not nil, unless the field is tagged omitempty
+//	nil slice, map, pointer             nil
+//	*T                                  a new pointer to a copy of *v
+//	map[K]V of integer keys K           lossless, as a decimal string
+//	time.Time                           the same instant, as an RFC 3339 string, without its monotonic reading
+//	                                    and in a fixed zone of its offset, unless UTC or Local
+//	any holding an integer              int64, if it fits into an int64, float64 otherwise
+//	any holding a float64               float64, or int64 if it holds an integer, such as 2.0
+//	any holding a slice, array          []any
+//	any holding a map, struct           map[string]any
+//	zero field tagged omitempty         zero, as the field is absent
+//	field tagged "-", unexported field  zero, as the field is absent
+//	*timestamppb.Timestamp, *anypb.Any  an error, as their RFC 3339 string or object is not decodable by encoding/json
+//	error                               an error, as an error is converted into the string of its message
+//
+// v and out as proto.Message round-trip as protojson does.
+func RoundTrip(v any, out any) error {
+	s, err := ToProtoStruct(v)
+	if err != nil {
+		return err
+	}
+	return FromProtoStruct(s, out)
+}
+
+// narrowNumbers replaces the json.Number held by interface values reachable from rv, as decoded by UseNumber,
+// by an int64 if it is an integer fitting into an int64, and by a float64 otherwise.
+func narrowNumbers(rv reflect.Value, depth int) {
+	if depth > maxReflectDepth || !rv.IsValid() {
+		return
+	}
+	if rv.CanAddr() && rv.Addr().Type().Implements(jsonUnmarshalerType) {
+		// decoded by a method of its own, which may keep json.Number
+		return
+	}
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if !rv.IsNil() {
+			narrowNumbers(rv.Elem(), depth+1)
+		}
+	case reflect.Interface:
+		if rv.IsNil() {
+			return
+		}
+		if n, ok := rv.Interface().(json.Number); ok {
+			if rv.CanSet() {
+				rv.Set(reflect.ValueOf(narrowNumber(n)))
+			}
+			return
+		}
+		narrowNumbers(rv.Elem(), depth+1)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			narrowNumbers(rv.Index(i), depth+1)
+		}
+	case reflect.Map:
+		// map values are not addressable, so narrow a copy of each and store it back
+		iter := rv.MapRange()
+		for iter.Next() {
+			mv := reflect.New(rv.Type().Elem()).Elem()
+			mv.Set(iter.Value())
+			narrowNumbers(mv, depth+1)
+			rv.SetMapIndex(iter.Key(), mv)
+		}
+	case reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			if rv.Type().Field(i).IsExported() {
+				narrowNumbers(rv.Field(i), depth+1)
+			}
+		}
+	}
+}
+
+// narrowNumber returns n as an int64 if it is an integer fitting into an int64, and as a float64 otherwise.
+func narrowNumber(n json.Number) any {
+	if i, err := n.Int64(); err == nil {
+		return i
+	}
+	f, _ := n.Float64()
+	return f
+}
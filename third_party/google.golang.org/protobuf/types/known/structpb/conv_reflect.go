@@ -0,0 +1,531 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package structpb
+
+import (
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// maxReflectDepth bounds the nesting walked by ToProtoStructReflect, as a guard against pointer cycles.
+const maxReflectDepth = 1000
+
+var (
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	jsonNumberType    = reflect.TypeOf(json.Number(""))
+)
+
+// ToProtoStructReflect converts v, which must marshal into a JSON object,
+// into a Google Struct proto, as ToProtoStruct does.
+// Unlike ToProtoStruct, it walks v by reflection and builds the Struct directly,
+// skipping the JSON text in between.
+// Values that customize their encoding, such as json.Marshaler and encoding.TextMarshaler,
+// fall back to encoding/json. Fields are promoted from embedded structs, and tagged with
+// "omitempty", "omitzero" and ",string", as encoding/json does.
+// Timestamp and Any are converted in their canonical JSON mapping, as protojson does.
+// Errors not customizing their encoding are converted into the strings of their messages.
+func ToProtoStructReflect(v any) (*structpb.Struct, error) {
+	if v == nil {
+		return &structpb.Struct{}, nil
+	}
+
+	switch v := v.(type) {
+	case *structpb.Struct:
+		// Fast path: if v is already a *structpb.Struct, nothing to do.
+		return v, nil
+	case []byte, *[]byte, string, *string, proto.Message:
+		// v is JSON text or a proto message already
+		return ToProtoStruct(v)
+	}
+
+	pv, err := reflectValue(reflect.ValueOf(v), 0)
+	if err != nil {
+		return nil, err
+	}
+	s, ok := pv.GetKind().(*structpb.Value_StructValue)
+	if !ok {
+		return nil, fmt.Errorf("structpb: cannot convert %T into a Struct, it does not marshal into a JSON object", v)
+	}
+	return s.StructValue, nil
+}
+
+func reflectValue(rv reflect.Value, depth int) (*structpb.Value, error) {
+	if depth > maxReflectDepth {
+		return nil, fmt.Errorf("structpb: encountered a cycle via %s", rv.Type())
+	}
+	if !rv.IsValid() {
+		return structpb.NewNullValue(), nil
+	}
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if rv.IsNil() {
+			return structpb.NewNullValue(), nil
+		}
+	}
+	if isWellKnownType(rv.Type()) {
+		return wellKnownValue(rv)
+	}
+	if marshaledByJson(rv) {
+		return jsonValue(rv)
+	}
+	if rv.Kind() != reflect.Interface && rv.Type().Implements(errorType) {
+		return structpb.NewStringValue(rv.Interface().(error).Error()), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		return reflectValue(rv.Elem(), depth+1)
+	case reflect.Bool:
+		return structpb.NewBoolValue(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return structpb.NewNumberValue(float64(rv.Int())), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return structpb.NewNumberValue(float64(rv.Uint())), nil
+	case reflect.Float32, reflect.Float64:
+		f := rv.Float()
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("structpb: unsupported value: %s", strconv.FormatFloat(f, 'g', -1, rv.Type().Bits()))
+		}
+		if rv.Kind() == reflect.Float32 {
+			// encoding/json formats a float32 in its shortest 32-bit form,
+			// which parses back into a float64 other than float64(float32).
+			f, _ = strconv.ParseFloat(strconv.FormatFloat(f, 'g', -1, 32), 64)
+		}
+		return structpb.NewNumberValue(f), nil
+	case reflect.String:
+		if rv.Type() == jsonNumberType {
+			return jsonValue(rv)
+		}
+		return structpb.NewStringValue(rv.String()), nil
+	case reflect.Slice:
+		if rv.IsNil() {
+			return structpb.NewNullValue(), nil
+		}
+		if rv.Type().Elem().Kind() == reflect.Uint8 && !marshaledByJson(reflect.New(rv.Type().Elem()).Elem()) {
+			return structpb.NewStringValue(base64.StdEncoding.EncodeToString(rv.Bytes())), nil
+		}
+		return reflectList(rv, depth)
+	case reflect.Array:
+		return reflectList(rv, depth)
+	case reflect.Map:
+		if rv.IsNil() {
+			return structpb.NewNullValue(), nil
+		}
+		return reflectMap(rv, depth)
+	case reflect.Struct:
+		return reflectStruct(rv, depth)
+	default:
+		return nil, fmt.Errorf("structpb: unsupported type: %s", rv.Type())
+	}
+}
+
+func reflectList(rv reflect.Value, depth int) (*structpb.Value, error) {
+	values := make([]*structpb.Value, rv.Len())
+	for i := range values {
+		v, err := reflectValue(rv.Index(i), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return structpb.NewListValue(&structpb.ListValue{Values: values}), nil
+}
+
+func reflectMap(rv reflect.Value, depth int) (*structpb.Value, error) {
+	kt := rv.Type().Key()
+	if kt.Kind() != reflect.String && kt.Implements(textMarshalerType) {
+		return jsonValue(rv)
+	}
+	fields := make(map[string]*structpb.Value, rv.Len())
+	iter := rv.MapRange()
+	for iter.Next() {
+		var name string
+		k := iter.Key()
+		switch k.Kind() {
+		case reflect.String:
+			name = k.String()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			name = strconv.FormatInt(k.Int(), 10)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			name = strconv.FormatUint(k.Uint(), 10)
+		default:
+			return nil, fmt.Errorf("structpb: unsupported map key type: %s", kt)
+		}
+		v, err := reflectValue(iter.Value(), depth+1)
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = v
+	}
+	return structpb.NewStructValue(&structpb.Struct{Fields: fields}), nil
+}
+
+func reflectStruct(rv reflect.Value, depth int) (*structpb.Value, error) {
+	fs := cachedStructFields(rv.Type())
+	fields := make(map[string]*structpb.Value, len(fs))
+	for _, f := range fs {
+		fv, ok := fieldByIndex(rv, f.index)
+		if !ok {
+			// promoted through a nil embedded pointer
+			continue
+		}
+		if (f.omitEmpty && isEmptyValue(fv)) || (f.omitZero && isZeroValue(fv)) {
+			continue
+		}
+		var v *structpb.Value
+		var err error
+		if f.quoted {
+			v, err = quotedValue(fv, depth+1)
+		} else {
+			v, err = reflectValue(fv, depth+1)
+		}
+		if err != nil {
+			return nil, err
+		}
+		fields[f.name] = v
+	}
+	return structpb.NewStructValue(&structpb.Struct{Fields: fields}), nil
+}
+
+// fieldByIndex returns the nested field of rv by index, as reflect.Value.FieldByIndex does,
+// or false if it walks through a nil embedded pointer.
+func fieldByIndex(rv reflect.Value, index []int) (reflect.Value, bool) {
+	for _, i := range index {
+		if rv.Kind() == reflect.Pointer {
+			if rv.IsNil() {
+				return reflect.Value{}, false
+			}
+			rv = rv.Elem()
+		}
+		rv = rv.Field(i)
+	}
+	return rv, true
+}
+
+// quotedValue converts rv, a field tagged with ",string", into the string of its JSON text, as encoding/json does.
+func quotedValue(rv reflect.Value, depth int) (*structpb.Value, error) {
+	if rv.Kind() == reflect.Pointer && !marshaledByJson(rv) {
+		if rv.IsNil() {
+			return structpb.NewNullValue(), nil
+		}
+		rv = rv.Elem()
+	}
+	if marshaledByJson(rv) {
+		return reflectValue(rv, depth)
+	}
+	jb, err := json.Marshal(rv.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	return structpb.NewStringValue(string(jb)), nil
+}
+
+// marshaledByJson reports whether encoding/json encodes rv by a method of its own.
+func marshaledByJson(rv reflect.Value) bool {
+	t := rv.Type()
+	if t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType) {
+		return true
+	}
+	if t.Kind() != reflect.Pointer && rv.CanAddr() {
+		pt := reflect.PointerTo(t)
+		return pt.Implements(jsonMarshalerType) || pt.Implements(textMarshalerType)
+	}
+	return false
+}
+
+// jsonValue converts rv into a Value through its JSON text, as ToProtoStruct does.
+func jsonValue(rv reflect.Value) (*structpb.Value, error) {
+	if rv.CanAddr() {
+		rv = rv.Addr()
+	}
+	jb, err := json.Marshal(rv.Interface())
+	if err != nil {
+		return nil, fmt.Errorf("json.Marshal: %w", err)
+	}
+	var v structpb.Value
+	if err := protojson.Unmarshal(jb, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+type structField struct {
+	name      string
+	tagged    bool
+	index     []int
+	typ       reflect.Type
+	omitEmpty bool
+	omitZero  bool
+	quoted    bool
+}
+
+var fieldCache sync.Map // map[reflect.Type][]structField
+
+// cachedStructFields returns the JSON fields of struct type t, as typeFields does.
+func cachedStructFields(t reflect.Type) []structField {
+	if f, ok := fieldCache.Load(t); ok {
+		return f.([]structField)
+	}
+	f, _ := fieldCache.LoadOrStore(t, typeFields(t))
+	return f.([]structField)
+}
+
+// typeFields returns the fields encoding/json encodes for struct type t, in the order of their indexes,
+// with the fields of embedded structs promoted by Go's visibility rules, as amended by JSON tags.
+// The algorithm is borrowed from encoding/json: a breadth-first search over the embedded structs.
+func typeFields(t reflect.Type) []structField {
+	// Anonymous fields to explore at the current level and the next.
+	var current []structField
+	next := []structField{{typ: t}}
+
+	// Count of queued names for current level and the next.
+	var count, nextCount map[reflect.Type]int
+
+	// Types already visited at an earlier level.
+	visited := map[reflect.Type]bool{}
+
+	var fields []structField
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[reflect.Type]int{}
+
+		for _, f := range current {
+			if visited[f.typ] {
+				continue
+			}
+			visited[f.typ] = true
+
+			for i := 0; i < f.typ.NumField(); i++ {
+				sf := f.typ.Field(i)
+				if sf.Anonymous {
+					t := sf.Type
+					if t.Kind() == reflect.Pointer {
+						t = t.Elem()
+					}
+					if !sf.IsExported() && t.Kind() != reflect.Struct {
+						// Ignore embedded fields of unexported non-struct types.
+						continue
+					}
+					// Do not ignore embedded fields of unexported struct types
+					// since they may have exported fields.
+				} else if !sf.IsExported() {
+					// Ignore unexported non-embedded fields.
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, opts, _ := strings.Cut(tag, ",")
+				if !isValidTag(name) {
+					name = ""
+				}
+				index := make([]int, len(f.index)+1)
+				copy(index, f.index)
+				index[len(f.index)] = i
+
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Pointer {
+					// Follow pointer.
+					ft = ft.Elem()
+				}
+
+				field := structField{name: name, tagged: name != "", index: index, typ: ft}
+				for opts != "" {
+					var opt string
+					opt, opts, _ = strings.Cut(opts, ",")
+					switch opt {
+					case "omitempty":
+						field.omitEmpty = true
+					case "omitzero":
+						field.omitZero = true
+					case "string":
+						// Only strings, floats, integers, and booleans can be quoted.
+						switch ft.Kind() {
+						case reflect.Bool,
+							reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+							reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+							reflect.Float32, reflect.Float64,
+							reflect.String:
+							field.quoted = true
+						}
+					}
+				}
+
+				// Record found field and index sequence.
+				if name != "" || !sf.Anonymous || ft.Kind() != reflect.Struct {
+					if field.name == "" {
+						field.name = sf.Name
+					}
+					fields = append(fields, field)
+					if count[f.typ] > 1 {
+						// If there were multiple instances, add a second,
+						// so that the annihilation code will see a duplicate.
+						// It only cares about the distinction between 1 and 2,
+						// so don't bother generating any more copies.
+						fields = append(fields, fields[len(fields)-1])
+					}
+					continue
+				}
+
+				// Record new anonymous struct to explore in next round.
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, structField{name: ft.Name(), index: index, typ: ft})
+				}
+			}
+		}
+	}
+
+	// Sort fields by name, breaking ties with depth, then
+	// breaking ties with "name came from json tag", then
+	// breaking ties with index sequence.
+	sort.Slice(fields, func(i, j int) bool {
+		x := fields
+		if x[i].name != x[j].name {
+			return x[i].name < x[j].name
+		}
+		if len(x[i].index) != len(x[j].index) {
+			return len(x[i].index) < len(x[j].index)
+		}
+		if x[i].tagged != x[j].tagged {
+			return x[i].tagged
+		}
+		return lessIndex(x[i].index, x[j].index)
+	})
+
+	// Delete all fields that are hidden by the Go rules for embedded fields,
+	// except that fields with JSON tags are promoted.
+
+	// The fields are sorted in primary order of name, secondary order
+	// of field index length. Loop over names; for each name, delete
+	// hidden fields by choosing the one dominant field that survives.
+	out := fields[:0]
+	for advance, i := 0, 0; i < len(fields); i += advance {
+		// One iteration per name.
+		// Find the sequence of fields with the name of this first field.
+		fi := fields[i]
+		for advance = 1; i+advance < len(fields); advance++ {
+			if fields[i+advance].name != fi.name {
+				break
+			}
+		}
+		if advance == 1 { // Only one field with this name
+			out = append(out, fi)
+			continue
+		}
+		if dominant, ok := dominantField(fields[i : i+advance]); ok {
+			out = append(out, dominant)
+		}
+	}
+	fields = out
+	sort.Slice(fields, func(i, j int) bool { return lessIndex(fields[i].index, fields[j].index) })
+	return fields
+}
+
+// dominantField looks through the fields, all of which are known to
+// have the same name, to find the single field that dominates the
+// others using Go's embedding rules, modified by the presence of
+// JSON tags. If there are multiple top-level fields, the boolean
+// will be false: This condition is an error in Go and we skip all
+// the fields.
+func dominantField(fields []structField) (structField, bool) {
+	// The fields are sorted in increasing index-length order, then by presence of tag.
+	// That means that the first field is the dominant one. We need only check
+	// for error cases: two fields at top level, either both tagged or neither tagged.
+	if len(fields) > 1 && len(fields[0].index) == len(fields[1].index) && fields[0].tagged == fields[1].tagged {
+		return structField{}, false
+	}
+	return fields[0], true
+}
+
+// lessIndex reports whether index sequence a sorts before b.
+func lessIndex(a, b []int) bool {
+	for k, ak := range a {
+		if k >= len(b) {
+			return false
+		}
+		if ak != b[k] {
+			return ak < b[k]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func isValidTag(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, c := range s {
+		switch {
+		case strings.ContainsRune("!#$%&()*+-./:;<=>?@[]^_{|}~ ", c):
+			// Backslash and quote chars are reserved, but
+			// otherwise any punctuation chars are allowed
+			// in a tag name.
+		case !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c > 0x7f):
+			return false
+		}
+	}
+	return true
+}
+
+func isEmptyValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return v.IsZero()
+	}
+	return false
+}
+
+type isZeroer interface {
+	IsZero() bool
+}
+
+var isZeroerType = reflect.TypeOf((*isZeroer)(nil)).Elem()
+
+// isZeroValue reports whether v is zero for the "omitzero" option,
+// by its IsZero method if it has one, as encoding/json does.
+func isZeroValue(v reflect.Value) bool {
+	t := v.Type()
+	switch {
+	case t.Kind() == reflect.Interface && t.Implements(isZeroerType):
+		// Avoid panics calling IsZero on a nil interface or
+		// non-nil interface with nil pointer.
+		return v.IsNil() || (v.Elem().Kind() == reflect.Pointer && v.Elem().IsNil()) || v.Interface().(isZeroer).IsZero()
+	case t.Kind() == reflect.Pointer && t.Implements(isZeroerType):
+		// Avoid panics calling IsZero on nil pointer.
+		return v.IsNil() || v.Interface().(isZeroer).IsZero()
+	case t.Implements(isZeroerType):
+		return v.Interface().(isZeroer).IsZero()
+	case reflect.PointerTo(t).Implements(isZeroerType):
+		if !v.CanAddr() {
+			// Temporarily box v so we can take the address.
+			v2 := reflect.New(t).Elem()
+			v2.Set(v)
+			v = v2
+		}
+		return v.Addr().Interface().(isZeroer).IsZero()
+	}
+	return v.IsZero()
+}
@@ -5,11 +5,23 @@
 package structpb_test
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/searKing/golang/third_party/google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	stpb "google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type Human struct {
@@ -88,3 +100,482 @@ func TestToProtoStruct(t *testing.T) {
 		}
 	}
 }
+
+type reflectFixture struct {
+	Int        int               `json:"int"`
+	Uint8      uint8             `json:"uint8,omitempty"`
+	Float32    float32           `json:"float32"`
+	Float64    float64           `json:"float64"`
+	Bool       bool              `json:"bool"`
+	Bytes      []byte            `json:"bytes"`
+	Number     json.Number       `json:"number"`
+	Time       time.Time         `json:"time"`
+	Ptr        *Human            `json:"ptr"`
+	NilPtr     *Human            `json:"nil_ptr"`
+	Omitted    string            `json:",omitempty"`
+	Ignored    string            `json:"-"`
+	Map        map[int]any       `json:"map"`
+	Array      [2]string         `json:"array"`
+	Interface  any               `json:"interface"`
+	Strings    map[string]string `json:"strings,omitempty"`
+	unexported int
+}
+
+type embeddedFixture struct {
+	Human
+	Age int `json:"age,string"`
+}
+
+type embeddedBase struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type EmbeddedNote struct {
+	Note string
+}
+
+type conflictA struct{ X, A int }
+
+type conflictB struct{ X, B int }
+
+type promotedFixture struct {
+	embeddedBase // unexported, its exported fields promoted
+	*EmbeddedNote
+	conflictA
+	conflictB
+	Tagged     embeddedBase `json:"tagged"`
+	Name       string       `json:"name"` // hides embeddedBase.Name
+	Quoted     *float64     `json:",string"`
+	QuotedStr  string       `json:",string"`
+	QuotedBool bool         `json:"quoted_bool,string"`
+	QuotedNil  *int         `json:",string"`
+	ZeroTime   time.Time    `json:",omitzero"`
+	ZeroInt    int          `json:",omitzero"`
+	Time       time.Time    `json:",omitzero"`
+}
+
+// referenceStruct returns the Struct of in marshaled by encoding/json and unmarshaled by protojson,
+// independently of the conversions of structpb.
+func referenceStruct(t *testing.T, in any) *stpb.Struct {
+	t.Helper()
+	data, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("json.Marshal(%+v): got: _, %v exp: _, nil", in, err)
+	}
+	var s stpb.Struct
+	if err := protojson.Unmarshal(data, &s); err != nil {
+		t.Fatalf("protojson.Unmarshal(%s): got: %v exp: nil", data, err)
+	}
+	return &s
+}
+
+func TestToProtoStructReflect(t *testing.T) {
+	inputs := []any{
+		map[string]any{},
+		map[string]any{"a": 1, "b": []any{"x", 2.5, nil, true}},
+		reflectFixture{
+			Int:       -7,
+			Float32:   0.1,
+			Float64:   1e300,
+			Bool:      true,
+			Bytes:     []byte("hello"),
+			Number:    "42.5",
+			Time:      time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+			Ptr:       &Human{Name: "Bob"},
+			Map:       map[int]any{1: "one", -2: map[string]int{"two": 2}},
+			Array:     [2]string{"a", "b"},
+			Interface: []int{1, 2, 3},
+		},
+		&reflectFixture{Uint8: 255, Omitted: "kept", Strings: map[string]string{"k": "v"}},
+		embeddedFixture{Human: Human{Name: "Eve"}, Age: 30},
+		promotedFixture{embeddedBase: embeddedBase{ID: 1, Name: "hidden"}, Name: "shown"},
+		promotedFixture{
+			EmbeddedNote: &EmbeddedNote{Note: "note"},
+			conflictA:    conflictA{X: 1, A: 2},
+			conflictB:    conflictB{X: 3, B: 4},
+			Tagged:       embeddedBase{ID: 2},
+			Quoted:       new(float64),
+			QuotedStr:    `<"s">`,
+			QuotedBool:   true,
+			ZeroInt:      5,
+			Time:         time.Date(2024, 1, 2, 3, 4, 5, 6, time.UTC),
+		},
+	}
+	for _, tt := range toProtoStructTests {
+		inputs = append(inputs, tt.input, &tt.input)
+	}
+
+	for i, in := range inputs {
+		t.Run(fmt.Sprintf("#%d: %T", i, in), func(t *testing.T) {
+			want := referenceStruct(t, in)
+			got, err := structpb.ToProtoStructReflect(in)
+			if err != nil {
+				t.Fatalf("ToProtoStructReflect(%+v): got: _, %v exp: _, nil", in, err)
+			}
+			if !proto.Equal(got, want) {
+				t.Errorf("ToProtoStructReflect(%+v): got: %v want: %v", in, got, want)
+			}
+		})
+	}
+
+	for _, in := range []any{1, []string{"a"}, struct{ F float64 }{math.NaN()}} {
+		if _, err := structpb.ToProtoStructReflect(in); err == nil {
+			t.Errorf("ToProtoStructReflect(%+v): got: _, nil exp: _, error", in)
+		}
+	}
+}
+
+func BenchmarkToProtoStruct(b *testing.B) {
+	in := toProtoStructTests[0].input
+	b.Run("json", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = structpb.ToProtoStruct(in)
+		}
+	})
+	b.Run("reflect", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			_, _ = structpb.ToProtoStructReflect(in)
+		}
+	})
+}
+
+type wellKnownBase struct {
+	ID        int                    `json:"id"`
+	CreatedAt *timestamppb.Timestamp `json:"created_at"`
+}
+
+type wellKnownEmbeddedFixture struct {
+	wellKnownBase
+	At    *timestamppb.Timestamp `json:"at"`
+	Count int                    `json:"count,string"`
+}
+
+type wellKnownFixture struct {
+	Name      string
+	CreatedAt *timestamppb.Timestamp
+	UpdatedAt timestamppb.Timestamp
+	Payload   *anypb.Any
+	Unknown   *anypb.Any
+	History   []*timestamppb.Timestamp
+	Extra     map[string]any
+	Missing   *timestamppb.Timestamp
+}
+
+func TestToProtoStructWellKnownTypes(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 600000000, time.UTC)
+	payload, err := anypb.New(wrapperspb.String("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	in := &wellKnownFixture{
+		Name:      "wkt",
+		CreatedAt: timestamppb.New(ts),
+		Payload:   payload,
+		Unknown:   &anypb.Any{TypeUrl: "type.googleapis.com/unknown.Message", Value: []byte{1, 2}},
+		History:   []*timestamppb.Timestamp{timestamppb.New(ts.Add(time.Second))},
+		Extra:     map[string]any{"at": timestamppb.New(ts.Add(time.Hour))},
+	}
+	in.UpdatedAt.Seconds = ts.Unix()
+
+	const want = `{
+		"Name": "wkt",
+		"CreatedAt": "2024-01-02T03:04:05.600Z",
+		"UpdatedAt": "2024-01-02T03:04:05Z",
+		"Payload": {"@type": "type.googleapis.com/google.protobuf.StringValue", "value": "hello"},
+		"Unknown": {"@type": "type.googleapis.com/unknown.Message", "value": "AQI="},
+		"History": ["2024-01-02T03:04:06.600Z"],
+		"Extra": {"at": "2024-01-02T04:04:05.600Z"},
+		"Missing": null
+	}`
+	var wantpb stpb.Struct
+	if err := protojson.Unmarshal([]byte(want), &wantpb); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, conv := range map[string]func(any) (*stpb.Struct, error){
+		"ToProtoStruct":        structpb.ToProtoStruct,
+		"ToProtoStructReflect": structpb.ToProtoStructReflect,
+	} {
+		got, err := conv(in)
+		if err != nil {
+			t.Fatalf("%s(%+v): got: _, %v exp: _, nil", name, in, err)
+		}
+		if !proto.Equal(got, &wantpb) {
+			t.Errorf("%s(%+v): got: %v want: %v", name, in, got, &wantpb)
+		}
+	}
+
+	// fields promoted from embedded structs, and tagged with ",string", convert field by field
+	embedded := wellKnownEmbeddedFixture{
+		wellKnownBase: wellKnownBase{ID: 1, CreatedAt: timestamppb.New(ts)},
+		At:            timestamppb.New(ts.Add(time.Second)),
+		Count:         2,
+	}
+	const wantEmbedded = `{
+		"id": 1,
+		"created_at": "2024-01-02T03:04:05.600Z",
+		"at": "2024-01-02T03:04:06.600Z",
+		"count": "2"
+	}`
+	if err := protojson.Unmarshal([]byte(wantEmbedded), &wantpb); err != nil {
+		t.Fatal(err)
+	}
+	for name, conv := range map[string]func(any) (*stpb.Struct, error){
+		"ToProtoStruct":        structpb.ToProtoStruct,
+		"ToProtoStructReflect": structpb.ToProtoStructReflect,
+	} {
+		got, err := conv(embedded)
+		if err != nil {
+			t.Fatalf("%s(%+v): got: _, %v exp: _, nil", name, embedded, err)
+		}
+		if !proto.Equal(got, &wantpb) {
+			t.Errorf("%s(%+v): got: %v want: %v", name, embedded, got, &wantpb)
+		}
+	}
+}
+
+func TestToProtoStructInterfaceValues(t *testing.T) {
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// values behind any holding no Timestamp, Any or error convert as encoding/json does
+	for _, in := range []any{
+		map[string]any{"name": "plain", "nested": map[string]any{"n": 1}, "list": []any{"a", 2.5, nil}},
+		struct {
+			Name  string
+			Value any
+		}{Name: "plain", Value: []int{1, 2}},
+	} {
+		got, err := structpb.ToProtoStruct(in)
+		if err != nil {
+			t.Fatalf("ToProtoStruct(%+v): got: _, %v exp: _, nil", in, err)
+		}
+		if want := referenceStruct(t, in); !proto.Equal(got, want) {
+			t.Errorf("ToProtoStruct(%+v): got: %v want: %v", in, got, want)
+		}
+	}
+
+	// and those holding one, however deep, convert it in its canonical JSON mapping
+	in := map[string]any{"list": []any{"a", map[string]any{"at": timestamppb.New(ts)}}}
+	got, err := structpb.ToProtoStruct(in)
+	if err != nil {
+		t.Fatalf("ToProtoStruct(%+v): got: _, %v exp: _, nil", in, err)
+	}
+	var want stpb.Struct
+	if err := protojson.Unmarshal([]byte(`{"list": ["a", {"at": "2024-01-02T03:04:05Z"}]}`), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(got, &want) {
+		t.Errorf("ToProtoStruct(%+v): got: %v want: %v", in, got, &want)
+	}
+}
+
+type panicMarshaler struct{}
+
+func (panicMarshaler) MarshalJSON() ([]byte, error) { panic("boom") }
+
+func TestToProtoStructSafe(t *testing.T) {
+	for _, in := range []any{
+		(*[]byte)(nil),
+		(*string)(nil),
+		map[string]any{"bad": panicMarshaler{}},
+	} {
+		got, err := structpb.ToProtoStructSafe(in)
+		if err == nil || got != nil {
+			t.Errorf("ToProtoStructSafe(%#v): got: %v, %v exp: nil, error", in, got, err)
+		}
+	}
+
+	got, err := structpb.ToProtoStructSafe(map[string]any{"Name": "safe"})
+	if err != nil || got.GetFields()["Name"].GetStringValue() != "safe" {
+		t.Errorf("ToProtoStructSafe: got: %v, %v exp: {Name: safe}, nil", got, err)
+	}
+}
+
+type errorBase struct {
+	Cause error `json:"cause"`
+}
+
+type errorFixture struct {
+	errorBase
+	Name    string        `json:"name"`
+	Code    int           `json:"code,string"`
+	Err     error         `json:"err"`
+	NilErr  error         `json:"nil_err"`
+	PathErr *fs.PathError `json:"path_err"`
+	Errs    []error       `json:"errs"`
+}
+
+func TestToProtoStructError(t *testing.T) {
+	in := errorFixture{
+		errorBase: errorBase{Cause: errors.New("cause")},
+		Name:      "errors",
+		Code:      7,
+		Err:       fmt.Errorf("wrapped: %w", errors.New("boom")),
+		PathErr:   &fs.PathError{Op: "open", Path: "/missing", Err: fs.ErrNotExist},
+		Errs:      []error{errors.New("first"), nil},
+	}
+	const want = `{
+		"cause": "cause",
+		"name": "errors",
+		"code": "7",
+		"err": "wrapped: boom",
+		"nil_err": null,
+		"path_err": "open /missing: file does not exist",
+		"errs": ["first", null]
+	}`
+	var wantpb stpb.Struct
+	if err := protojson.Unmarshal([]byte(want), &wantpb); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, conv := range map[string]func(any) (*stpb.Struct, error){
+		"ToProtoStruct":        structpb.ToProtoStruct,
+		"ToProtoStructReflect": structpb.ToProtoStructReflect,
+	} {
+		got, err := conv(in)
+		if err != nil {
+			t.Fatalf("%s(%+v): got: _, %v exp: _, nil", name, in, err)
+		}
+		if !proto.Equal(got, &wantpb) {
+			t.Errorf("%s(%+v): got: %v want: %v", name, in, got, &wantpb)
+		}
+	}
+}
+
+type omitEmptyFixture struct {
+	V []int `json:",omitempty"`
+}
+
+type ignoredFixture struct {
+	V int `json:"-"`
+	v int
+}
+
+type roundTripTest struct {
+	name    string
+	in      any // marshals into a JSON object
+	out     any // a pointer to a new value to round-trip into
+	want    any // *out after RoundTrip
+	wantErr bool
+}
+
+// roundTripField returns a test round-tripping v as the field of a struct, into want.
+func roundTripField[T any](name string, v T, want T) roundTripTest {
+	return roundTripTest{name: name, in: struct{ V T }{v}, out: new(struct{ V T }), want: struct{ V T }{want}}
+}
+
+// roundTripFieldError returns a test round-tripping v as the field of a struct, failing.
+func roundTripFieldError[T any](name string, v T) roundTripTest {
+	return roundTripTest{name: name, in: struct{ V T }{v}, out: new(struct{ V T }), wantErr: true}
+}
+
+func TestRoundTrip(t *testing.T) {
+	one := 1
+	ts := time.Date(2024, 1, 2, 3, 4, 5, 600000007, time.UTC)
+	tests := []roundTripTest{
+		// lossless
+		roundTripField("bool", true, true),
+		roundTripField("string", "s", "s"),
+		roundTripField("int8", int8(math.MinInt8), int8(math.MinInt8)),
+		roundTripField("int32", int32(math.MinInt32), int32(math.MinInt32)),
+		roundTripField("uint8", uint8(math.MaxUint8), uint8(math.MaxUint8)),
+		roundTripField("uint32", uint32(math.MaxUint32), uint32(math.MaxUint32)),
+		roundTripField("int", 10000000, 10000000),
+		roundTripField("int64 2^53", int64(1<<53), int64(1<<53)),
+		roundTripField("int64 -2^53", int64(-1<<53), int64(-1<<53)),
+		roundTripField("float64", 0.1, 0.1),
+		roundTripField("float64 max", math.MaxFloat64, math.MaxFloat64),
+		roundTripField("float32", float32(0.1), float32(0.1)),
+		roundTripField("float32 smallest", float32(math.SmallestNonzeroFloat32), float32(math.SmallestNonzeroFloat32)),
+		roundTripField("bytes", []byte("x"), []byte("x")),
+		roundTripField("empty bytes", []byte{}, []byte{}),
+		roundTripField("empty slice", []int{}, []int{}),
+		roundTripField("empty map", map[string]int{}, map[string]int{}),
+		roundTripField("nil slice", []int(nil), nil),
+		roundTripField("nil map", map[string]int(nil), nil),
+		roundTripField("nil pointer", (*int)(nil), nil),
+		roundTripField("pointer", &one, &one),
+		roundTripField("integer keys", map[int]string{-1: "a", 2: "b"}, map[int]string{-1: "a", 2: "b"}),
+		roundTripField("time", ts, ts),
+		{
+			name: "map",
+			in:   map[string]any{"n": 1, "f": 0.5, "l": []any{1, 2.5, "s"}, "m": map[string]any{"n": 2}},
+			out:  new(map[string]any),
+			want: map[string]any{"n": int64(1), "f": 0.5, "l": []any{int64(1), 2.5, "s"}, "m": map[string]any{"n": int64(2)}},
+		},
+		{
+			name: "proto.Message",
+			in:   &stpb.Struct{Fields: map[string]*stpb.Value{"n": stpb.NewNumberValue(1)}},
+			out:  new(stpb.Struct),
+			want: &stpb.Struct{Fields: map[string]*stpb.Value{"n": stpb.NewNumberValue(1)}},
+		},
+
+		// lossy
+		roundTripField("int64 2^53+1", int64(1<<53+1), int64(1<<53)),
+		roundTripField("any int", any(3), any(int64(3))),
+		roundTripField("any float64", any(0.5), any(0.5)),
+		roundTripField("any integral float64", any(2.0), any(int64(2))),
+		roundTripField("any int64 max", any(int64(math.MaxInt64)), any(float64(math.MaxInt64))),
+		roundTripField("any slice", any([]int{1}), any([]any{int64(1)})),
+		roundTripField("any array", any([2]string{"a", "b"}), any([]any{"a", "b"})),
+		roundTripField("any map", any(map[string]int{"a": 1}), any(map[string]any{"a": int64(1)})),
+		roundTripField("any struct", any(Human{Name: "Alice"}), any(map[string]any{"Name": "Alice", "Friends": nil, "Strangers": nil})),
+		roundTripField("map of any", map[string]any{"a": 1}, map[string]any{"a": int64(1)}),
+		roundTripField("slice of any", []any{1, 0.5}, []any{int64(1), 0.5}),
+		{name: "omitempty", in: omitEmptyFixture{V: []int{}}, out: new(omitEmptyFixture), want: omitEmptyFixture{}},
+		{name: "ignored", in: ignoredFixture{V: 1}, out: new(ignoredFixture), want: ignoredFixture{}},
+		{name: "unexported", in: ignoredFixture{v: 1}, out: new(ignoredFixture), want: ignoredFixture{}},
+
+		// failing
+		roundTripFieldError("uint64 max", uint64(math.MaxUint64)),
+		roundTripFieldError("NaN", math.NaN()),
+		roundTripFieldError("Inf", math.Inf(1)),
+		roundTripFieldError("Timestamp", timestamppb.New(ts)),
+		roundTripFieldError("error", errors.New("boom")),
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := structpb.RoundTrip(tt.in, tt.out)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("RoundTrip(%#v): got: %#v, nil exp: _, error", tt.in, reflect.ValueOf(tt.out).Elem().Interface())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RoundTrip(%#v): got: _, %v exp: _, nil", tt.in, err)
+			}
+			got := reflect.ValueOf(tt.out).Elem().Interface()
+			if m, ok := tt.want.(proto.Message); ok {
+				if !proto.Equal(tt.out.(proto.Message), m) {
+					t.Errorf("RoundTrip(%v): got: %v want: %v", tt.in, tt.out, m)
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RoundTrip(%#v): got: %#v want: %#v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromProtoStruct(t *testing.T) {
+	s, err := structpb.ToProtoStruct(toProtoStructTests[0].input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got Human
+	if err := structpb.FromProtoStruct(s, &got); err != nil {
+		t.Fatalf("FromProtoStruct(%v): got: %v exp: nil", s, err)
+	}
+	if !reflect.DeepEqual(got, toProtoStructTests[0].input) {
+		t.Errorf("FromProtoStruct(%v): got: %+v want: %+v", s, got, toProtoStructTests[0].input)
+	}
+
+	if err := structpb.FromProtoStruct(s, got); err == nil {
+		t.Errorf("FromProtoStruct(%v) into a non-pointer: got: nil exp: error", s)
+	}
+}
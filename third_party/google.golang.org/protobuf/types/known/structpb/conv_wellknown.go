@@ -0,0 +1,170 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package structpb
+
+import (
+	"encoding/base64"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	timestampType = reflect.TypeOf((*timestamppb.Timestamp)(nil)).Elem()
+	anyType       = reflect.TypeOf((*anypb.Any)(nil)).Elem()
+	errorType     = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// isWellKnownType reports whether t is a Timestamp or an Any, or a pointer to one.
+func isWellKnownType(t reflect.Type) bool {
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t == timestampType || t == anyType
+}
+
+// wellKnownKind tells whether the values of a type hold a Timestamp, an Any or an error.
+type wellKnownKind int
+
+const (
+	// wellKnownNone is of types whose values never hold one.
+	wellKnownNone wellKnownKind = iota
+	// wellKnownMaybe is of types whose values may hold one behind an interface only,
+	// known by the value at run time.
+	wellKnownMaybe
+	// wellKnownAlways is of types that hold one by their static type.
+	wellKnownAlways
+)
+
+var wellKnownCache sync.Map // map[reflect.Type]wellKnownKind
+
+// wellKnownKindOf tells whether the values of type t hold a Timestamp or an Any,
+// which encoding/json would marshal as plain structs, or an error, which encoding/json
+// would marshal by its fields, mostly as {}.
+func wellKnownKindOf(t reflect.Type) wellKnownKind {
+	if kind, ok := wellKnownCache.Load(t); ok {
+		return kind.(wellKnownKind)
+	}
+	kind := scanWellKnownType(t, make(map[reflect.Type]bool))
+	wellKnownCache.Store(t, kind)
+	return kind
+}
+
+func scanWellKnownType(t reflect.Type, seen map[reflect.Type]bool) wellKnownKind {
+	if isWellKnownType(t) || t.Implements(errorType) {
+		return wellKnownAlways
+	}
+	if seen[t] {
+		return wellKnownNone
+	}
+	seen[t] = true
+	switch t.Kind() {
+	case reflect.Interface:
+		// may hold one at run time
+		return wellKnownMaybe
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return scanWellKnownType(t.Elem(), seen)
+	case reflect.Struct:
+		kind := wellKnownNone
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() && !sf.Anonymous {
+				continue
+			}
+			switch scanWellKnownType(sf.Type, seen) {
+			case wellKnownAlways:
+				return wellKnownAlways
+			case wellKnownMaybe:
+				kind = wellKnownMaybe
+			}
+		}
+		return kind
+	}
+	return wellKnownNone
+}
+
+// maxWellKnownDepth bounds the walk of holdsWellKnownValue, against values referring to themselves.
+const maxWellKnownDepth = 1000
+
+// holdsWellKnownValue reports whether rv holds a Timestamp, an Any or an error at run time,
+// walking into the interfaces, pointers, slices, arrays, map values and struct fields
+// whose types may hold one.
+func holdsWellKnownValue(rv reflect.Value, depth int) bool {
+	if !rv.IsValid() || depth > maxWellKnownDepth {
+		return false
+	}
+	switch wellKnownKindOf(rv.Type()) {
+	case wellKnownNone:
+		return false
+	case wellKnownAlways:
+		if rv.Kind() != reflect.Interface {
+			return true
+		}
+	}
+	switch rv.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		return !rv.IsNil() && holdsWellKnownValue(rv.Elem(), depth+1)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < rv.Len(); i++ {
+			if holdsWellKnownValue(rv.Index(i), depth+1) {
+				return true
+			}
+		}
+	case reflect.Map:
+		iter := rv.MapRange()
+		for iter.Next() {
+			if holdsWellKnownValue(iter.Value(), depth+1) {
+				return true
+			}
+		}
+	case reflect.Struct:
+		t := rv.Type()
+		for i := 0; i < t.NumField(); i++ {
+			if sf := t.Field(i); (sf.IsExported() || sf.Anonymous) && holdsWellKnownValue(rv.Field(i), depth+1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wellKnownValue converts a Timestamp or an Any held by rv into a Value,
+// in the canonical JSON mapping of protojson:
+// a Timestamp becomes an RFC 3339 string, and an Any becomes an object
+// with its type URL in "@type" and the fields of the message it holds.
+// An Any whose message type is not linked in becomes {"@type": url, "value": base64}.
+func wellKnownValue(rv reflect.Value) (*structpb.Value, error) {
+	if rv.Kind() != reflect.Pointer {
+		if !rv.CanAddr() {
+			v := reflect.New(rv.Type()).Elem()
+			v.Set(rv)
+			rv = v
+		}
+		rv = rv.Addr()
+	}
+	m := rv.Interface().(proto.Message)
+	jb, err := protojson.Marshal(m)
+	if err != nil {
+		a, ok := m.(*anypb.Any)
+		if !ok {
+			return nil, fmt.Errorf("protojson.Marshal: %w", err)
+		}
+		return structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+			"@type": structpb.NewStringValue(a.GetTypeUrl()),
+			"value": structpb.NewStringValue(base64.StdEncoding.EncodeToString(a.GetValue())),
+		}}), nil
+	}
+	var v structpb.Value
+	if err := protojson.Unmarshal(jb, &v); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
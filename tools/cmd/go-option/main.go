@@ -152,6 +152,162 @@
 // generate methods for multiple types. The default output file is t_string.go,
 // where t is the lower-cased name of the first type listed. It can be overridden
 // with the -output flag.
+//
+// The option struct tag controls the option of a field, as the json tag does for
+// encoding/json: `option:"Title"` names the option after Title instead of the
+// field, `option:",short"` drops the type name from the option name, such as
+// WithAge instead of WithPillAge, and `option:"-"` skips the field, so that no
+// option is generated for it, such as to keep internal bookkeeping fields, like
+// mutexes and caches, out of the generated API. A field skipped is still copied
+// by With<Type>, With<Type>Defaults and Snapshot, which set or copy the whole type.
+//
+// A slice or map field gets two options, With<Type><Field> appending to it and
+// With<Type><Field>Replace setting it. The `option:",replace"` tag keeps the one
+// setting it only, and `option:",append"` the one appending to it only, named
+// With<Type><Field> either way, such as for a field which is only ever replaced.
+//
+// The fields of a struct embedded by value, declared in the package, get options
+// too, by the names Go promotes them with, such as With<Type>Timeout setting
+// Timeout of an embedded Base, next to With<Type>Base setting Base as a whole. As
+// in Go, a field hides the fields of the same name embedded deeper, and fields of
+// the same name at the same depth are ambiguous, so that neither gets an option.
+// Structs embedded by pointer, which may be nil, or tagged `option:"-"` are not
+// descended into.
+//
+// A field of pointer type, such as Retries *int, gets With<Type><Field>Value too,
+// next to With<Type><Field>: it takes the value, such as WithRetriesValue(v int),
+// and stores a pointer to a copy of it, made each time the option is applied, so
+// that callers need no pointer and the structs the option is applied to share none.
+//
+// The -locked flag guards option application by a sync.Mutex field, which the type
+// must declare itself, such as mu sync.Mutex. The generated ApplyOptions holds the
+// mutex while applying all options, and a generated Snapshot method returns a copy
+// of all other fields under the same mutex, so that readers never observe a
+// half-applied configuration. The mutex field gets no option of its own, and
+// With<Type>, which would overwrite the mutex, is not generated.
+//
+// A field sets its default value by a "// +optional-default:<value>" directive in
+// its doc or line comment, such as Timeout time.Duration // +optional-default:5s.
+// The value is parsed by the field type: time.Duration by time.ParseDuration, and
+// booleans, strings and numbers as Go literals. The generated
+// _default_<Type>_<Field>_value holds the default, qualified by the package name the
+// field type is imported as, such as 5 * time.Second, and With<Type><Field>Default
+// sets the field to it. Any other field type fails the generation.
+//
+// A field tagged `default:"<value>"` sets its default the same way, such as
+// Backoff time.Duration `default:"250ms"`, the tag value taken verbatim, commas
+// included. A field setting its default by both the directive and the tag fails
+// the generation.
+//
+// With<Type>Defaults resets the whole type to _default_<Type>_value, the zero
+// value but for the fields with a default. Options are applied in order, so
+// ApplyOptions(userOpts..., With<Type>Defaults(), finalOverrides...) discards the
+// effects of userOpts while finalOverrides still apply. A generic type has no
+// default var, its option resets to the zero value of the instantiated type
+// instead, and a type generated with -locked gets no With<Type>Defaults, as it
+// would overwrite the mutex.
+//
+// The -named flag makes every field option record the name of the field it sets:
+// the option returned by With<Type><Field> is a <Type>OptionNamed, a struct
+// wrapping the option func with a Name() string method. <Type>OptionNames lists
+// the names of a set of options, such as for logging which fields a configuration
+// was overridden by, and ApplyOptions calls the <Type>OptionAppliedHook package
+// variable, if set, with the name of every named option applied. The tradeoff is
+// a struct allocated per option instead of a bare func, and a hook shared by all
+// instances of the type, which must be set before options are applied
+// concurrently. Options built from a bare <Type>OptionFunc stay unnamed.
+//
+// The -patch flag generates <Type>Patch, a struct of pointers to the fields with
+// options, and an ApplyPatch method setting the fields whose pointers are not nil,
+// leaving the others unchanged, such as for PATCH-style updates. The json tags of
+// the fields are kept, so that a JSON Merge Patch decoded into a <Type>Patch updates
+// the members present only; a null member is taken as absent, not as a reset to the
+// zero value. ApplyPatch holds the mutex of a type generated with -locked, and is a
+// function taking the type as its first argument for an imported type.
+//
+// The -di flag bridges the options with dependency injection frameworks.
+// Provide<Type>(opts ...<Type>Option) *<Type> returns a new <Type> with options
+// applied, a constructor to provide to google/wire, which takes the variadic
+// options as a []<Type>Option to be provided too, or to uber/fx. For uber/fx, the
+// generated file imports go.uber.org/fx: <Type>FxOption() provides *<Type> built by
+// Provide<Type> with all the options in the value group <Type>OptionGroup, in no
+// particular order, and <Type>OptionFxSupply(opts...) contributes options to the
+// group from any module, such as:
+//
+//	fx.New(
+//		NumberFxOption(),
+//		NumberOptionFxSupply(WithNumberValue(1)),
+//		fx.Invoke(func(n *Number) { /* n.Value == 1 */ }),
+//	)
+//
+// The -validate flag lets options fail, such as an option rejecting a port out
+// of 1-65535. <Type>Option gets an applyCheck(*<Type>) error method, and
+// ApplyOptionsReturnError(opts...) error applies options in order as
+// ApplyOptions does, but stops at the first option failing and returns its
+// error, the options before it applied already. The options generated never
+// fail; <Type>OptionCheckFunc wraps a func(*<Type>) error into an option which
+// may, as <Type>OptionFunc wraps a func(*<Type>). ApplyOptions, kept for
+// backward compatibility, cannot report failure and ignores the errors.
+// ApplyOptionsReturnError holds the mutex of a type generated with -locked, and
+// is a function taking the type as its first argument for an imported type.
+//
+// The -fixed-arity=N flag generates ApplyOptions1 to ApplyOptionsN, taking 1 to
+// N options as parameters instead of the variadic slice of ApplyOptions, such
+// as o.ApplyOptions2(opt1, opt2). The slice escapes to the heap, one allocation
+// per call, when escape analysis cannot keep it on the stack, such as when
+// ApplyOptions is called through a func value, while the fixed forms allocate
+// nothing, for hot constructors applying a small, fixed set of options. They
+// skip nil options, hold the mutex of a type generated with -locked, and are
+// functions taking the type as their first argument for an imported type.
+// testdata/fixedarity benchmarks both forms.
+//
+// A type with type parameters, such as type Cache[K cmp.Ordered, V any, S ~[]K]
+// struct{...}, carries them through to CacheOption[K, V, S],
+// CacheOptionFunc[K, V, S], the WithCache* functions and the receiver of
+// ApplyOptions, with their constraints as go/types checked them, naming other
+// packages as the file imports them. Go infers them from the arguments of
+// WithCache* only if they all appear in the field type, so callers instantiate
+// the others, such as WithCacheName[string, int, []string]("lru").
+//
+// The -prefix flag replaces With<Type> in the names of the options setting
+// fields, such as -prefix=Set generating SetNumReps instead of
+// WithHashRingNumReps; -prefix=With<Name> names both the options and the option
+// interface after <Name> instead, such as WithRingNumReps and RingOption. It
+// composes with -trimprefix, trimming the type name naming the option
+// interface, so that -trimprefix=Hash -prefix=Set generates SetNumReps and
+// RingOption. Options setting the whole type, such as WithHashRing, keep their
+// names.
+//
+// The -builder flag generates a <Type>Builder, for callers preferring chained
+// calls to ApplyOptions, such as
+// NewHashRingBuilder().WithNumReps(3).WithNodes("a", "b").Build(). Its methods,
+// named as the options without the type name, collect the same options, With
+// appends any other option, and Build returns a new <Type> with them applied in
+// order.
+//
+// The -durationhelpers flag generates With<Type><Field>Seconds(n int) and
+// With<Type><Field>Millis(n int) next to the option of each time.Duration field,
+// setting the field to n seconds or milliseconds, so that callers need not import
+// time. Fields are told by their type as resolved by go/types, whatever package
+// name time is imported as, and pointers to time.Duration get no helpers.
+//
+// The -getters flag generates a Get<Field> method of <Type> next to the options of
+// each field, named as the option without the type name, such as GetTimeout
+// returning timeout, so that unexported fields are readable from outside the
+// package. With -locked, getters hold the mutex as Snapshot does. A type imported
+// from another package gets no getters, as no methods can be declared on it.
+//
+// The -gentest flag also writes <type>_options_test.go next to the generated
+// options, asserting that the option of each field of a simple kind, a
+// time.Duration, boolean, string or number, sets the field to a sample value, and
+// that ApplyOptions(nil) leaves the type unchanged, so that regenerating the options
+// is guarded against regressions. A generic type gets no test, as there are no type
+// arguments to instantiate it with.
+//
+// The generated files keep the //go:build and // +build lines of the file declaring
+// the type, so that they build only where the type exists, such as a type declared
+// under //go:build linux, whatever build tags were passed by -tags to load the
+// package.
 package main
 
 import (
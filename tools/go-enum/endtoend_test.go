@@ -79,6 +79,19 @@ func buildEnum(t *testing.T) (dir string, goenum string) {
 	return dir, goenum
 }
 
+// testdataFlags are the flags go-enum is run with for the programs in testdata, by the name of their directory,
+// besides -transform for the types named after it.
+var testdataFlags = map[string][]string{
+	"random":          {"-random"},
+	"stable":          {"-stable"},
+	"caseinsensitive": {"-ci"},
+	"bitflags":        {"-flags"},
+	"linecomment":     {"-linecomment"},
+	"sqlint":          {"-sqlint"},
+	"csvgob":          {"-csv", "-gob"},
+	"iter":            {"-iter"},
+}
+
 // goenumCompileAndRun runs stringer for the named file and compiles and
 // runs the target binary in directory dir. That binary will panic if the String method is incorrect.
 func goenumCompileAndRun(t *testing.T, dir, goenum, typeName, fileName string) {
@@ -98,17 +111,14 @@ func goenumCompileAndRun(t *testing.T, dir, goenum, typeName, fileName string) {
 	enumSource := filepath.Join(filepath.Dir(source), castTypeNameToFileName(typeName+"_enum.go"))
 
 	// Run goenum in temporary directory.
+	args := []string{"-type", typeName, "-trimprefix", typeName}
 	if strings.Contains(strings.ToLower(typeName), "transform") {
-		// trimprefix
-		err = run(goenum, "-type", typeName, "-trimprefix", typeName, "-transform", "lower", "-output", enumSource, source)
-		if err != nil {
-			t.Fatal(err)
-		}
-	} else {
-		err = run(goenum, "-type", typeName, "-trimprefix", typeName, "-output", enumSource, source)
-		if err != nil {
-			t.Fatal(err)
-		}
+		args = append(args, "-transform", "lower")
+	}
+	args = append(args, testdataFlags[filepath.Base(filepath.Dir(fileName))]...)
+	err = run(goenum, append(args, "-output", enumSource, source)...)
+	if err != nil {
+		t.Fatal(err)
 	}
 	// Run the binary in the temporary directory.
 	err = run("go", "run", enumSource, source)
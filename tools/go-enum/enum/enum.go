@@ -10,6 +10,9 @@
 //	text        ==>  encoding.TextMarshaler and encoding.TextUnmarshaler
 //	sql         ==>  database/sql.Scanner and database/sql/driver.Valuer
 //	yaml        ==>  gopkg.in/yaml.v3:yaml.Marshaler and gopkg.in/yaml.v3:yaml.Unmarshaler
+//	flag        ==>  flag.Value
+//	csv         ==>  github.com/gocarina/gocsv.TypeMarshaller and github.com/gocarina/gocsv.TypeUnmarshaller
+//	gob         ==>  encoding/gob.GobEncoder and encoding/gob.GobDecoder
 //
 // Given the name of a (signed or unsigned) integer type T that has constants
 // defined, stringer will create a new self-contained Go source file implementing
@@ -31,6 +34,14 @@
 //	yaml        ==>  gopkg.in/yaml.v3:yaml.Marshaler and gopkg.in/yaml.v3:yaml.Unmarshaler
 //		func (t T) MarshalYAML() (interface{}, error)
 //		func (t *T) UnmarshalYAML(unmarshal func(interface{}) error) error
+//	flag        ==>  flag.Value
+//		func (t *T) Set(s string) error
+//	csv         ==>  github.com/gocarina/gocsv.TypeMarshaller and github.com/gocarina/gocsv.TypeUnmarshaller
+//		func (t T) MarshalCSV() (string, error)
+//		func (t *T) UnmarshalCSV(s string) error
+//	gob         ==>  encoding/gob.GobEncoder and encoding/gob.GobDecoder
+//		func (t T) GobEncode() ([]byte, error)
+//		func (t *T) GobDecode(data []byte) error
 //
 // The file is created in the same package and directory as the package that defines T.
 // It has helpful defaults designed for use with go generate.
@@ -87,6 +98,42 @@
 //	PillAspirin // Aspirin
 //
 // to suppress it in the output.
+//
+// A line comment of the form enum:"name", as a struct tag, sets the name printed regardless of
+// the -linecomment flag, so that a constant can be printed, marshaled and parsed by a custom name:
+//
+//	PillAspirin // enum:"aspirin_tablet"
+//
+// Names from line comments are printed as written, not transformed by the -transform flag.
+// Two values of T sharing the same name fail to generate, as parsing the name would be ambiguous.
+//
+// The -random flag generates a TRandom(r *rand.Rand) T function for tests, returning
+// a value chosen uniformly at random from the registered values of T.
+//
+// The -stable flag generates a StableKey() string method and a ParseTStableKey(key string) (T, error)
+// function, persisting values of T by the names of their constants instead of their numbers, which
+// change as constants are reordered. Two values of T sharing the same name fail to generate.
+//
+// The -ci flag generates a ParseTStringCaseInsensitive(s string) (T, error) function, parsing
+// the names of T as ParseTString does but ignoring case, for config files and user input.
+// ParseTString stays exact. Two names of T differing only in case fail to compile.
+//
+// The -flags flag generates T as bit flags, whose constants must be distinct powers of two:
+// String prints a combined value as the names of the flags set in it joined by "|", such as
+// "Read|Write", or "" if no flag is set, ParseTString parses them back, and Has(f T) bool, SetFlag(f T) T and ClearFlag(f T) T
+// test, set and clear flags in a value.
+//
+// The -iter flag generates a TSeq() iter.Seq[T] function, iterating the registered values of T in the order of TValues.
+// It is not generated by default, as the iter package needs go1.23 or later.
+//
+// The -new flag generates New() *T and Ptr() *T methods, returning a pointer to a copy of the value,
+// such as to set an optional field of *T to a constant, whose address cannot be taken.
+//
+// The Scan method of sql reads a value from its name, or from its number, such as of an integer column,
+// failing unless the number is registered. Value writes the name, or the number with the -sqlint flag.
+//
+// The -csv and -gob flags implement the csv and gob interfaces above, which are not implemented by default,
+// marshaling values of T by their names, as the text and binary ones do.
 package enum
 
 import (
@@ -101,6 +148,7 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -117,9 +165,18 @@ var (
 	useText   bool
 	useJson   bool
 	useSql    bool
+	useSqlInt bool
 	useYaml   bool
+	useFlag   bool
+	useCsv    bool
+	useGob    bool
 
 	useContains     bool
+	useRandom       bool
+	useStable       bool
+	useCI           bool
+	useFlags        bool
+	useIter         bool
 	transformMethod string
 	output          string
 	trimprefix      string
@@ -131,16 +188,30 @@ func ParseCommandLine(def bool) *flag.FlagSet {
 	var commandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
 	commandLine.StringVar(&typeInfos, "type", "", "comma-separated list of type names; must be set")
 	commandLine.BoolVar(&useAll, "all", def, "if true, all interfaces will be implemented default. Default: true.")
-	commandLine.BoolVar(&useNew, "new", def, "if true, the New will be implemented. Default: true.")
+	commandLine.BoolVar(&useNew, "new", def, "if true, the New and Ptr will be implemented. Default: true.")
 	commandLine.BoolVar(&useString, "string", def, "if true, the fmt.Stringer interface will be implemented. Default: true, you can use stringer instead.")
 	commandLine.BoolVar(&useBinary, "binary", def, "if true, the encoding.BinaryMarshaler and encoding.BinaryUnmarshaler interface will be implemented. Default: true")
 	commandLine.BoolVar(&useText, "text", def, "if true, the encoding.TextMarshaler and encoding.TextUnmarshaler interface will be implemented. Default: true")
 	commandLine.BoolVar(&useJson, "json", def, "if true, the encoding/json.Marshaler and encoding/json.Unmarshaler interface will be implemented. Default: true")
 	commandLine.BoolVar(&useSql, "sql", def, "if true, the database/sql.Scanner and database/sql/driver.Valuer interface will be implemented. Default: true")
+	commandLine.BoolVar(&useSqlInt, "sqlint", false, "if true, the database/sql/driver.Valuer interface will write values as integers instead of their names, Scan reading both. Default: false")
 	commandLine.BoolVar(&useYaml, "yaml", def, "if true, the gopkg.in/yaml.v3:yaml.Marshaler and gopkg.in/yaml.v3:yaml.Unmarshaler interface will be implemented. Default: true")
+	commandLine.BoolVar(&useFlag, "flag", def, "if true, the flag.Value interface will be implemented. Default: true")
+	commandLine.BoolVar(&useCsv, "csv", false, "if true, the github.com/gocarina/gocsv.TypeMarshaller and github.com/gocarina/gocsv.TypeUnmarshaller interface will be implemented. Default: false")
+	commandLine.BoolVar(&useGob, "gob", false, "if true, the encoding/gob.GobEncoder and encoding/gob.GobDecoder interface will be implemented. Default: false")
 
 	commandLine.BoolVar(&useContains, "contains", def, "if true, the XXXSliceContains|XXXSliceContainsAny methods will be generated(XXX will be replaced by typename), such as strings.Contains|ContainsAny. Default: true")
 
+	commandLine.BoolVar(&useRandom, "random", false, "if true, the XXXRandom method will be generated(XXX will be replaced by typename), returning a random registered value for tests. Default: false")
+
+	commandLine.BoolVar(&useStable, "stable", false, "if true, the StableKey method and ParseXXXStableKey will be generated(XXX will be replaced by typename), persisting values by their names instead of their numbers. Default: false")
+
+	commandLine.BoolVar(&useCI, "ci", false, "if true, ParseXXXStringCaseInsensitive will be generated(XXX will be replaced by typename), parsing names ignoring case. Default: false")
+
+	commandLine.BoolVar(&useFlags, "flags", false, "if true, the enum will be generated as bit flags, combined values printed and parsed as names joined by \"|\", the Has|SetFlag|ClearFlag methods generated. The constants must be distinct powers of two. Default: false")
+
+	commandLine.BoolVar(&useIter, "iter", false, "if true, the XXXSeq method will be generated(XXX will be replaced by typename), returning an iter.Seq over the values, which needs go1.23 or later. Default: false")
+
 	commandLine.StringVar(&transformMethod, "transform", "nop", "enum item name transformation method [nop, upper, lower, snake, upper_camel, lower_camel, kebab, dotted]. Default: nop")
 
 	commandLine.StringVar(&output, "output", "", "output file name; default srcdir/<type>_enum.go")
@@ -170,19 +241,19 @@ const (
 func Main() {
 	log.SetFlags(0)
 	log.SetPrefix("go-enum: ")
-	ParseCommandLine(true)
+	commandLine := ParseCommandLine(true)
 	if len(typeInfos) == 0 {
-		flag.Usage()
+		commandLine.Usage()
 		os.Exit(2)
 	}
 	if !useAll {
-		ParseCommandLine(false)
+		commandLine = ParseCommandLine(false)
 	}
 
 	// type <key, value> type <key, value>
 	typs := newTypeInfo(typeInfos)
 	if len(typs) == 0 {
-		flag.Usage()
+		commandLine.Usage()
 		os.Exit(3)
 	}
 
@@ -192,7 +263,7 @@ func Main() {
 	}
 
 	// We accept either one directory or a list of files. Which do we have?
-	args := flag.Args()
+	args := commandLine.Args()
 	if len(args) == 0 {
 		// Default: process whole package in current directory.
 		args = []string{"."}
@@ -383,6 +454,9 @@ func (g *Generator) generate(typeInfo typeInfo) {
 		log.Fatalf("no values defined for type %+v", typeInfo)
 	}
 	g.transformValueNames(values, transformMethod)
+	if useFlags {
+		checkFlags(values, typeInfo.Name)
+	}
 	// Generate code that will fail if the constants change value.
 	for _, im := range checkImportPackages {
 		g.Printf(stringImport, im)
@@ -418,10 +492,46 @@ func (g *Generator) generate(typeInfo typeInfo) {
 			g.Printf(stringImport, im)
 		}
 	}
+	if useFlag {
+		for _, im := range flagImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
+	if useGob {
+		for _, im := range gobImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
+	if useRandom {
+		for _, im := range randomImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
+	if useStable {
+		for _, im := range stableImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
+	if useCI {
+		for _, im := range caseInsensitiveImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
+	if useFlags {
+		for _, im := range flagsImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
+	if useIter {
+		for _, im := range iterImportPackages {
+			g.Printf(stringImport, im)
+		}
+	}
 
 	g.buildEnumRegenerateCheck(values)
 
 	runs := splitIntoRuns(values)
+	checkNames(runs, typeInfo.Name)
 	threshold := 10
 
 	if useString {
@@ -438,6 +548,8 @@ func (g *Generator) generate(typeInfo typeInfo) {
 		// is very low. And bitmasks probably deserve their own analysis,
 		// to be done some other day.
 		switch {
+		case useFlags:
+			g.buildFlags(runs, typeInfo, threshold)
 		case len(runs) == 1:
 			g.buildOneRun(runs, typeInfo)
 		case len(runs) <= threshold:
@@ -468,12 +580,37 @@ func (g *Generator) generate(typeInfo typeInfo) {
 	}
 	if useSql {
 		g.buildCheck(runs, typeInfo.Name, threshold)
-		g.Printf(sqpTemplate, typeInfo.Name)
+		g.buildSql(runs, typeInfo.Name, useSqlInt)
+	}
+	if useFlag {
+		g.buildCheck(runs, typeInfo.Name, threshold)
+		g.Printf(flagTemplate, typeInfo.Name)
+	}
+	if useCsv {
+		g.buildCheck(runs, typeInfo.Name, threshold)
+		g.Printf(csvTemplate, typeInfo.Name)
+	}
+	if useGob {
+		g.buildCheck(runs, typeInfo.Name, threshold)
+		g.Printf(gobTemplate, typeInfo.Name)
 	}
 
 	if useContains {
 		g.Printf(containsTemplate, typeInfo.Name)
 	}
+	if useRandom {
+		g.buildCheck(runs, typeInfo.Name, threshold)
+		g.Printf(randomTemplate, typeInfo.Name)
+	}
+	if useIter {
+		g.buildCheck(runs, typeInfo.Name, threshold)
+	}
+	if useStable {
+		g.buildStable(runs, typeInfo.Name)
+	}
+	if useCI {
+		g.buildCaseInsensitive(runs, typeInfo.Name)
+	}
 }
 
 // splitIntoRuns breaks the values into runs of contiguous sequences.
@@ -540,6 +677,7 @@ func (g *Generator) goimport(src []byte) []byte {
 type NameInfo struct {
 	originalName string // The name of the constant.
 	trimmedName  string // The name with trimmed prefix.
+	custom       bool   // Whether trimmedName is taken from the line comment, not to be transformed.
 }
 type TypeInfo struct {
 	originalName string // The name of the constant.
@@ -674,8 +812,9 @@ func (f *File) genDecl(node ast.Node) bool {
 			if c := vspec.Comment; f.lineComment && c != nil {
 				v.comment = c.Text()
 			}
-			if c := vspec.Comment; f.lineComment && c != nil && len(c.List) == 1 {
-				v.nameInfo.trimmedName = strings.TrimSpace(c.Text())
+			if name, ok := lineCommentName(vspec.Comment, f.lineComment); ok {
+				v.nameInfo.trimmedName = name
+				v.nameInfo.custom = true
 			} else {
 				v.nameInfo.trimmedName = strings.TrimPrefix(v.nameInfo.originalName, f.trimPrefix)
 			}
@@ -687,6 +826,39 @@ func (f *File) genDecl(node ast.Node) bool {
 
 // Helpers
 
+// TagEnum is the key of the tag in a line comment setting the name of the constant, as enum:"name".
+const TagEnum = "enum"
+
+// lineCommentName returns the name of the constant set by its line comment, if any:
+// the value of the enum tag, as enum:"name", or the text of the comment if lineComment is set.
+func lineCommentName(c *ast.CommentGroup, lineComment bool) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	text := strings.TrimSpace(c.Text())
+	if name, ok := reflect.StructTag(text).Lookup(TagEnum); ok && name != "" {
+		return name, true
+	}
+	if lineComment && len(c.List) == 1 && text != "" {
+		return text, true
+	}
+	return "", false
+}
+
+// checkNames exits if two values of the type share the same name, breaking parsing the name back.
+// The values are deduplicated already, as by splitIntoRuns.
+func checkNames(runs [][]Value, typeName string) {
+	seen := make(map[string]string)
+	for _, values := range runs {
+		for _, v := range values {
+			if name, ok := seen[v.nameInfo.trimmedName]; ok {
+				log.Fatalf("%s and %s of %s share the name %q", name, v.nameInfo.originalName, typeName, v.nameInfo.trimmedName)
+			}
+			seen[v.nameInfo.trimmedName] = v.nameInfo.originalName
+		}
+	}
+}
+
 // usize returns the number of bits of the smallest unsigned integer
 // type that will hold n. Used to create the smallest possible slice of
 // integers to use as indexes into the concatenated strings.
@@ -762,7 +934,7 @@ func (g *Generator) declareNameVars(runs [][]Value, typeName string, suffix stri
 	g.Printf("const _%s_name%s = \"", typeName, suffix)
 	for _, run := range runs {
 		for i := range run {
-			g.Printf("%s", run[i].typeInfo.originalName)
+			g.Printf("%s", run[i].nameInfo.trimmedName)
 		}
 	}
 	g.Printf("\"\n")
@@ -824,14 +996,19 @@ func (g *Generator) buildMap(runs [][]Value, typeInfo typeInfo) {
 	typeName := typeInfo.Name
 	g.Printf("\n")
 	g.declareNameVars(runs, typeName, "")
+	g.declareNameMap(runs, typeName)
+	g.Printf(stringMap, typeName)
+}
+
+// declareNameMap declares the map between the values and their names, sliced from the concatenated names string.
+func (g *Generator) declareNameMap(runs [][]Value, typeName string) {
 	g.Printf("\nvar _%s_map = map[%s]string{\n", typeName, typeName)
 	n := 0
 	for _, values := range runs {
 		for _, value := range values {
-			g.Printf("\t%s: _%s_name[%d:%d],\n", &value, typeName, n, n+len(value.typeInfo.originalName))
-			n += len(value.typeInfo.originalName)
+			g.Printf("\t%s: _%s_name[%d:%d],\n", &value, typeName, n, n+len(value.nameInfo.trimmedName))
+			n += len(value.nameInfo.trimmedName)
 		}
 	}
 	g.Printf("}\n\n")
-	g.Printf(stringMap, typeName)
 }
@@ -0,0 +1,87 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for some of the internal functions.
+
+package enum
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"testing"
+)
+
+func TestSplitIntoRuns(t *testing.T) {
+	tests := []struct {
+		input  []int64
+		output [][]int64
+	}{
+		// No need for a test for the empty case; that's picked off before splitIntoRuns.
+		{[]int64{0}, [][]int64{{0}}},
+		{[]int64{0, 1, 2, 3}, [][]int64{{0, 1, 2, 3}}},
+		{[]int64{3, 1, 2, 1}, [][]int64{{1, 2, 3}}},
+		// explicit, non-contiguous values, such as HTTP status codes.
+		{[]int64{200, 201, 204, 301, 404, 500, 503}, [][]int64{{200, 201}, {204}, {301}, {404}, {500}, {503}}},
+		{[]int64{500, -100, 200, 2}, [][]int64{{-100}, {2}, {200}, {500}}},
+		{[]int64{-2, -1, 0, 1, 10, 11}, [][]int64{{-2, -1, 0, 1}, {10, 11}}},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d: %v", i, tt.input), func(t *testing.T) {
+			values := make([]Value, len(tt.input))
+			for j, v := range tt.input {
+				values[j] = Value{valueInfo: ValueInfo{value: uint64(v), signed: true, str: fmt.Sprint(v)}}
+			}
+			var got [][]int64
+			for _, run := range splitIntoRuns(values) {
+				var r []int64
+				for _, v := range run {
+					r = append(r, int64(v.valueInfo.value))
+				}
+				got = append(got, r)
+			}
+			if !slices.EqualFunc(got, tt.output, slices.Equal) {
+				t.Errorf("splitIntoRuns(%v): got %v; expected %v", tt.input, got, tt.output)
+			}
+		})
+	}
+}
+
+func TestLineCommentName(t *testing.T) {
+	comment := func(lines ...string) *ast.CommentGroup {
+		c := &ast.CommentGroup{}
+		for _, line := range lines {
+			c.List = append(c.List, &ast.Comment{Text: line})
+		}
+		return c
+	}
+	tests := []struct {
+		comment     *ast.CommentGroup
+		lineComment bool
+		name        string
+		ok          bool
+	}{
+		{nil, true, "", false},
+		{comment("// new_connection"), false, "", false},
+		{comment("// new_connection"), true, "new_connection", true},
+		{comment("//  new connection  "), true, "new connection", true},
+		{comment("//"), true, "", false},
+		{comment("// a", "// b"), true, "", false},
+		{comment(`// enum:"new_connection"`), false, "new_connection", true},
+		{comment(`// enum:"new_connection"`), true, "new_connection", true},
+		{comment(`// enum:"new_connection" json:"new"`), false, "new_connection", true},
+		{comment(`// enum:"new" note`), false, "new", true},
+		{comment(`// enum:""`), false, "", false},
+		{comment(`// New enum:"new"`), false, "", false},
+		{comment("// a", `// enum:"b"`), false, "", false},
+	}
+	for i, tt := range tests {
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			name, ok := lineCommentName(tt.comment, tt.lineComment)
+			if name != tt.name || ok != tt.ok {
+				t.Errorf("lineCommentName(%v): got %q, %t; expected %q, %t", tt.lineComment, name, ok, tt.name, tt.ok)
+			}
+		})
+	}
+}
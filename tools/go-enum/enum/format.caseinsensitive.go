@@ -0,0 +1,49 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"strconv"
+	"strings"
+)
+
+var caseInsensitiveImportPackages = []string{`fmt`, `strings`}
+
+// buildCaseInsensitive prints the table between the lower-cased names and the values of the type,
+// and the parser using it.
+// The lower-cased names are emitted as quoted string constants, so that two values whose names differ
+// only in case fail to compile as duplicate keys in a map literal.
+func (g *Generator) buildCaseInsensitive(runs [][]Value, typeName string) {
+	g.Printf("\nfunc _() {\n")
+	g.Printf("\t// A \"duplicate key\" compiler error signifies that names of %s differ only in case.\n", typeName)
+	g.Printf("\t// Rename the constants, or their line comments, and re-run the go-enum command.\n")
+	g.Printf("\t_ = _%s_lower_name_to_values\n", typeName)
+	g.Printf("}\n")
+
+	g.Printf("\nvar _%[1]s_lower_name_to_values = map[string]%[1]s{\n", typeName)
+	for _, values := range runs {
+		for _, value := range values {
+			g.Printf("\t%s: %s,\n", strconv.Quote(strings.ToLower(value.nameInfo.trimmedName)), &value)
+		}
+	}
+	g.Printf("}\n")
+
+	g.Printf(caseInsensitiveTemplate, typeName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+const caseInsensitiveTemplate = `
+// Parse%[1]sStringCaseInsensitive retrieves an enum value from the enum constants string name,
+// ignoring case, so that "active" and "ACTIVE" both parse as "Active" does.
+// Throws an error if the param is not part of the enum, in any case.
+func Parse%[1]sStringCaseInsensitive(s string) (%[1]s, error) {
+	if val, ok := _%[1]s_lower_name_to_values[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%%s does not belong to %[1]s values", s)
+}
+`
@@ -9,7 +9,10 @@ import (
 	"sync"
 )
 
-var checkImportPackages = []string{`fmt`}
+var checkImportPackages = []string{`fmt`, `strconv`}
+
+// iterImportPackages are imported with -iter only, so that a module older than go1.23 can build the other methods.
+var iterImportPackages = []string{`iter`, `slices`}
 
 // Arguments to format are:
 //
@@ -25,6 +28,21 @@ func Parse%[1]sString(s string) (%[1]s, error) {
 }
 `
 
+// Arguments to format are:
+//
+//	[1]: type name
+const stringMustNameToValueMethod = `
+// MustParse%[1]sString is like Parse%[1]sString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParse%[1]sString(s string) %[1]s {
+	val, err := Parse%[1]sString(s)
+	if err != nil {
+		panic("MustParse%[1]sString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+`
+
 // Arguments to format are:
 //
 //	[1]: type name
@@ -35,11 +53,48 @@ func %[1]sValues() []%[1]s {
 }
 `
 
+// Arguments to format are:
+//
+//	[1]: type name
+const stringSeqMethod = `
+// %[1]sSeq returns an iterator over all values of the enum, in the order of %[1]sValues.
+func %[1]sSeq() iter.Seq[%[1]s] {
+	return slices.Values(_%[1]s_values)
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+const stringNextPrevMethods = `
+// Next returns the registered value of %[1]s following i, in the order of %[1]sValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i %[1]s) Next() (%[1]s, bool) {
+	for _, v := range _%[1]s_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of %[1]s preceding i, in the order of %[1]sValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i %[1]s) Prev() (%[1]s, bool) {
+	for j := len(_%[1]s_values) - 1; j >= 0; j-- {
+		if v := _%[1]s_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+`
+
 // Arguments to format are:
 //
 //	[1]: type name
 const stringBelongsMethodLoop = `
-// IsA%[1]s returns "true" if the value is listed in the enum definition. "false" otherwise
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
 func (i %[1]s) Registered() bool {
 	for _, v := range _%[1]s_values {
 		if i == v {
@@ -54,9 +109,9 @@ func (i %[1]s) Registered() bool {
 //
 //	[1]: type name
 const stringBelongsMethodSet = `
-// IsA%[1]s returns "true" if the value is listed in the enum definition. "false" otherwise
-func (i %[1]s) IsA%[1]s() bool {
-	_, ok := _%[1]sMap[i] 
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i %[1]s) Registered() bool {
+	_, ok := _%[1]s_map[i]
 	return ok
 }
 `
@@ -97,8 +152,17 @@ func (g *Generator) buildCheck(runs [][]Value, typeName string, runsThreshold in
 		g.Printf("}\n\n")
 
 		// Print the basic extra methods
-		g.Printf(stringNameToValueMethod, typeName)
+		if useFlags {
+			g.Printf(flagsNameToValueMethod, typeName, flagsSeparator)
+		} else {
+			g.Printf(stringNameToValueMethod, typeName)
+		}
+		g.Printf(stringMustNameToValueMethod, typeName)
 		g.Printf(stringValuesMethod, typeName)
+		if useIter {
+			g.Printf(stringSeqMethod, typeName)
+		}
+		g.Printf(stringNextPrevMethods, typeName)
 		if len(runs) <= runsThreshold {
 			g.Printf(stringBelongsMethodLoop, typeName)
 		} else { // There is a map of values, the code is simpler then
@@ -0,0 +1,22 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+// Arguments to format are:
+//
+//	[1]: type name
+const csvTemplate = `
+// MarshalCSV marshals %[1]s as a field of CSV, as github.com/gocarina/gocsv.TypeMarshaller does
+func (i %[1]s) MarshalCSV() (string, error) {
+	return i.String(), nil
+}
+
+// UnmarshalCSV unmarshals %[1]s from a field of CSV, as github.com/gocarina/gocsv.TypeUnmarshaller does
+func (i *%[1]s) UnmarshalCSV(s string) error {
+	var err error
+	*i, err = Parse%[1]sString(s)
+	return err
+}
+`
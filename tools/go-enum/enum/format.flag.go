@@ -0,0 +1,30 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+var flagImportPackages = []string{`flag`}
+
+// Arguments to format are:
+//
+//	[1]: type name
+const flagTemplate = `
+func _() {
+	var _nil_%[1]s_value = func() (val %[1]s) { return }()
+
+	// An "cannot convert %[1]s literal (type %[1]s) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_%[1]s_value
+}
+
+// Set implements the flag.Value interface for %[1]s, so that a %[1]s can be a command-line flag, by flag.Var.
+func (i *%[1]s) Set(s string) error {
+	v, err := Parse%[1]sString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+`
@@ -0,0 +1,137 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"log"
+	"strconv"
+)
+
+var flagsImportPackages = []string{`fmt`, `strconv`, `strings`}
+
+// flagsSeparator joins the names of the flags set in a value, as printed by String and parsed by ParseTString.
+// No flag set is printed as "".
+const flagsSeparator = "|"
+
+// checkFlags exits unless the values of the type are distinct powers of two, as bit flags are.
+func checkFlags(values []Value, typeName string) {
+	seen := make(map[uint64]string, len(values))
+	for _, v := range values {
+		value := v.valueInfo.value
+		if value == 0 || value&(value-1) != 0 || (v.valueInfo.signed && int64(value) < 0) {
+			log.Fatalf("-flags: %s of %s is %s, not a power of two", v.nameInfo.originalName, typeName, v.valueInfo.str)
+		}
+		if name, ok := seen[value]; ok {
+			log.Fatalf("-flags: %s and %s of %s share the value %s", name, v.nameInfo.originalName, typeName, v.valueInfo.str)
+		}
+		seen[value] = v.nameInfo.originalName
+	}
+}
+
+// buildFlags generates the variables and String method for bit flags, printing a combined value
+// as the names of the flags set in it joined by flagsSeparator.
+// The variables of the name of each value are declared as for a plain enum, for buildCheck.
+func (g *Generator) buildFlags(runs [][]Value, typeInfo typeInfo, runsThreshold int) {
+	typeName := typeInfo.Name
+	g.Printf("\n")
+	switch {
+	case len(runs) == 1:
+		g.declareIndexAndNameVar(runs[0], typeName)
+	case len(runs) <= runsThreshold:
+		g.declareIndexAndNameVars(runs, typeName)
+	default:
+		g.declareNameVars(runs, typeName, "")
+		g.declareNameMap(runs, typeName)
+	}
+
+	g.Printf("\nvar _%s_flags = []struct {\n", typeName)
+	g.Printf("\tflag %s\n", typeName)
+	g.Printf("\tname string\n")
+	g.Printf("}{\n")
+	for _, values := range runs {
+		for _, value := range values {
+			g.Printf("\t{%s, %s},\n", &value, strconv.Quote(value.nameInfo.trimmedName))
+		}
+	}
+	g.Printf("}\n")
+
+	g.Printf(flagsTemplate, typeName, flagsSeparator)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: separator of the names of the flags
+const flagsTemplate = `
+func _() {
+	var _nil_%[1]s_value = func() (val %[1]s) { return }()
+
+	// An "cannot convert %[1]s literal (type %[1]s) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_%[1]s_value
+}
+
+// String returns the names of the flags set in i, joined by "%[2]s", in the order of their values,
+// followed by the bits left, if any, as %[1]s(bits), or "" if no bit is set.
+func (i %[1]s) String() string {
+	if i == 0 {
+		return ""
+	}
+	var names []string
+	rest := i
+	for _, f := range _%[1]s_flags {
+		if i&f.flag == f.flag {
+			names = append(names, f.name)
+			rest &^= f.flag
+		}
+	}
+	if rest != 0 {
+		names = append(names, "%[1]s("+strconv.FormatInt(int64(rest), 10)+")")
+	}
+	return strings.Join(names, "%[2]s")
+}
+
+// Has reports whether all the flags set in f are set in i.
+func (i %[1]s) Has(f %[1]s) bool {
+	return i&f == f
+}
+
+// SetFlag returns i with the flags set in f set.
+func (i %[1]s) SetFlag(f %[1]s) %[1]s {
+	return i | f
+}
+
+// ClearFlag returns i with the flags set in f cleared.
+func (i %[1]s) ClearFlag(f %[1]s) %[1]s {
+	return i &^ f
+}
+`
+
+// Arguments to format are:
+//
+//	[1]: type name
+//	[2]: separator of the names of the flags
+const flagsNameToValueMethod = `
+// Parse%[1]sString retrieves an enum value from the enum constants string name,
+// or from the names of the flags set in it joined by "%[2]s", as String returns, "" being no flag set.
+// Throws an error if any name is not part of the enum.
+func Parse%[1]sString(s string) (%[1]s, error) {
+	if val, ok := _%[1]s_name_to_values[s]; ok {
+		return val, nil
+	}
+	if s == "" {
+		return 0, nil
+	}
+	var val %[1]s
+	for _, name := range strings.Split(s, "%[2]s") {
+		f, ok := _%[1]s_name_to_values[strings.TrimSpace(name)]
+		if !ok {
+			return 0, fmt.Errorf("%%s does not belong to %[1]s values", s)
+		}
+		val |= f
+	}
+	return val, nil
+}
+`
@@ -0,0 +1,37 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+var gobImportPackages = []string{`encoding/gob`}
+
+// Arguments to format are:
+//
+//	[1]: type name
+const gobTemplate = `
+func _() {
+	var _nil_%[1]s_value = func() (val %[1]s) { return }()
+
+	// An "cannot convert %[1]s literal (type %[1]s) to type gob.GobEncoder" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ gob.GobEncoder = _nil_%[1]s_value
+
+	// An "cannot convert %[1]s literal (type %[1]s) to type gob.GobDecoder" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ gob.GobDecoder = &_nil_%[1]s_value
+}
+
+// GobEncode implements the encoding/gob.GobEncoder interface for %[1]s,
+// encoding i by its name, as MarshalBinary does
+func (i %[1]s) GobEncode() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// GobDecode implements the encoding/gob.GobDecoder interface for %[1]s
+func (i *%[1]s) GobDecode(data []byte) error {
+	var err error
+	*i, err = Parse%[1]sString(string(data))
+	return err
+}
+`
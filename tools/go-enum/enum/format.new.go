@@ -15,4 +15,10 @@ func (i %[1]s) New () *%[1]s {
 	clone := i
 	return &clone
 }
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *%[1]s
+// to a constant, whose address cannot be taken.
+func (i %[1]s) Ptr() *%[1]s {
+	return &i
+}
 `
@@ -0,0 +1,18 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+var randomImportPackages = []string{`math/rand`}
+
+// Arguments to format are:
+//
+//	[1]: type name
+const randomTemplate = `
+// %[1]sRandom returns a value chosen uniformly at random by r from the registered values of %[1]s,
+// for property-based and fuzz tests. A seeded r makes tests reproducible.
+func %[1]sRandom(r *rand.Rand) %[1]s {
+	return _%[1]s_values[r.Intn(len(_%[1]s_values))]
+}
+`
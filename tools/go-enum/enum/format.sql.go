@@ -4,11 +4,37 @@
 
 package enum
 
-var sqlImportPackages = []string{`database/sql`, `database/sql/driver`}
+import "strings"
+
+var sqlImportPackages = []string{`database/sql`, `database/sql/driver`, `reflect`}
+
+// buildSql prints the database/sql.Scanner and database/sql/driver.Valuer methods of the type.
+// Value writes the name of a value, or its number if sqlInt is set. Scan reads either, so that a column
+// keeps being read as it is written, whichever is chosen. A number scanned must be a registered value,
+// or a combination of the flags with -flags, 0 being no flag set.
+func (g *Generator) buildSql(runs [][]Value, typeName string, sqlInt bool) {
+	value := "i.String()"
+	if sqlInt {
+		value = "int64(i)"
+	}
+	registered := "val.Registered()"
+	if useFlags {
+		var flags []string
+		for _, values := range runs {
+			for _, v := range values {
+				flags = append(flags, v.String())
+			}
+		}
+		registered = "val&^(" + strings.Join(flags, " | ") + ") == 0"
+	}
+	g.Printf(sqpTemplate, typeName, value, registered)
+}
 
 // Arguments to format are:
 //
 //	[1]: type name
+//	[2]: driver.Value of i, its name or number
+//	[3]: whether val scanned from a number is registered
 const sqpTemplate = `
 func _() {
 	var _nil_%[1]s_value = func() (val %[1]s) { return }()
@@ -24,29 +50,48 @@ func _() {
 }
 
 func (i %[1]s) Value() (driver.Value, error) {
-	return i.String(), nil
+	return %[2]s, nil
 }
 
+// Scan implements the database/sql.Scanner interface for %[1]s, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of %[1]s.
 func (i *%[1]s) Scan(value interface{}) error {
 	if value == nil {
 		return nil
 	}
 
-	str, ok := value.(string)
-	if !ok {
-		bytes, ok := value.([]byte)
-		if !ok {
-			return fmt.Errorf("value is not a byte slice")
+	var val %[1]s
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = Parse%[1]sString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = Parse%[1]sString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = %[1]s(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = %[1]s(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = %[1]s(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %%T is not a string, byte slice or number", value)
+		}
+		if !exact || !(%[3]s) {
+			return fmt.Errorf("%%v does not belong to %[1]s values", value)
 		}
-
-		str = string(bytes[:])
 	}
 
-	val, err := Parse%[1]sString(str)
-	if err != nil {
-		return err
-	}
-	
 	*i = val
 	return nil
 }
@@ -0,0 +1,54 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package enum
+
+import "strconv"
+
+var stableImportPackages = []string{`fmt`}
+
+// buildStable prints the tables between the values and the stable keys of the type, and the methods using them.
+// The stable keys are the names printed, unique as checkNames fails the generation on two values sharing a name,
+// such as by -transform or -linecomment.
+func (g *Generator) buildStable(runs [][]Value, typeName string) {
+	g.Printf("\nvar _%[1]s_stable_key_to_values = map[string]%[1]s{\n", typeName)
+	for _, values := range runs {
+		for _, value := range values {
+			g.Printf("\t%s: %s,\n", strconv.Quote(value.nameInfo.trimmedName), &value)
+		}
+	}
+	g.Printf("}\n")
+
+	g.Printf("\nvar _%[1]s_value_to_stable_keys = map[%[1]s]string{\n", typeName)
+	for _, values := range runs {
+		for _, value := range values {
+			g.Printf("\t%s: %s,\n", &value, strconv.Quote(value.nameInfo.trimmedName))
+		}
+	}
+	g.Printf("}\n")
+
+	g.Printf(stableTemplate, typeName)
+}
+
+// Arguments to format are:
+//
+//	[1]: type name
+const stableTemplate = `
+// StableKey returns the name of the constant i is, the same as String does, as the key to persist i by.
+// Unlike the value of i, it is stable across reordering the constants of %[1]s, and is the persistence
+// contract: renaming a constant breaks it instead. Parse%[1]sStableKey parses it back.
+// It returns "" if i is not registered.
+func (i %[1]s) StableKey() string {
+	return _%[1]s_value_to_stable_keys[i]
+}
+
+// Parse%[1]sStableKey retrieves an enum value from its stable key, as returned by StableKey.
+// Throws an error if the key is not the stable key of any value of the enum.
+func Parse%[1]sStableKey(key string) (%[1]s, error) {
+	if val, ok := _%[1]s_stable_key_to_values[key]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%%s is not a stable key of %[1]s values", key)
+}
+`
@@ -55,6 +55,9 @@ func (g *Generator) transformValueNames(values []Value, transformMethod string)
 	}
 
 	for i := range values {
+		if values[i].nameInfo.custom {
+			continue
+		}
 		values[i].nameInfo.trimmedName = mapfunc(values[i].nameInfo.trimmedName)
 	}
 }
@@ -0,0 +1,134 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Bit flags, printed and parsed combined.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//go:generate go-enum -type BitFlags -trimprefix BitFlags -flags
+type BitFlags uint8
+
+const (
+	BitFlagsRead BitFlags = 1 << iota
+	BitFlagsWrite
+	BitFlagsExec
+	_
+	BitFlagsSticky
+)
+
+func main() {
+	ck(BitFlagsRead, "Read")
+	ck(BitFlagsWrite, "Write")
+	ck(BitFlagsSticky, "Sticky")
+	ck(BitFlagsRead|BitFlagsExec, "Read|Exec")
+	ck(BitFlagsSticky|BitFlagsWrite|BitFlagsRead, "Read|Write|Sticky")
+	ck(BitFlagsRead|BitFlagsWrite|BitFlagsExec|BitFlagsSticky, "Read|Write|Exec|Sticky")
+	ck(0, "")
+	ckString(1<<3, "BitFlags(8)")
+	ckString(BitFlagsRead|1<<3|1<<7, "Read|BitFlags(136)")
+
+	if got, err := ParseBitFlagsString("Exec | Read"); err != nil || got != BitFlagsRead|BitFlagsExec {
+		panic(fmt.Sprintf("BitFlags.go: ParseBitFlagsString(%q) got %s, %v", "Exec | Read", got, err))
+	}
+	for _, s := range []string{"|", "Read|", "Read|Delete", "read", "BitFlags(8)", "Read,Write"} {
+		if _, err := ParseBitFlagsString(s); err == nil {
+			panic(fmt.Sprintf("BitFlags.go: ParseBitFlagsString(%q) got no error", s))
+		}
+	}
+
+	f := BitFlagsRead
+	f = f.SetFlag(BitFlagsWrite | BitFlagsExec)
+	if f != BitFlagsRead|BitFlagsWrite|BitFlagsExec {
+		panic(fmt.Sprintf("BitFlags.go: SetFlag got %s", f))
+	}
+	if !f.Has(BitFlagsWrite) || !f.Has(BitFlagsRead|BitFlagsExec) || f.Has(BitFlagsSticky) || f.Has(BitFlagsRead|BitFlagsSticky) {
+		panic(fmt.Sprintf("BitFlags.go: %s.Has is wrong", f))
+	}
+	f = f.ClearFlag(BitFlagsRead | BitFlagsSticky)
+	if f != BitFlagsWrite|BitFlagsExec {
+		panic(fmt.Sprintf("BitFlags.go: ClearFlag got %s", f))
+	}
+
+	var d BitFlags
+	if err := d.Scan(int64(BitFlagsRead | BitFlagsSticky)); err != nil || d != BitFlagsRead|BitFlagsSticky {
+		panic(fmt.Sprintf("BitFlags.go: Scan(%d) got %s, %v", BitFlagsRead|BitFlagsSticky, d, err))
+	}
+	for _, v := range []int64{1 << 3, int64(BitFlagsRead | 1<<3), 1 << 8} {
+		if err := d.Scan(v); err == nil {
+			panic(fmt.Sprintf("BitFlags.go: Scan(%d) got no error", v))
+		}
+	}
+
+	var g BitFlags
+	if err := g.UnmarshalText([]byte("Write|Sticky")); err != nil || g != BitFlagsWrite|BitFlagsSticky {
+		panic(fmt.Sprintf("BitFlags.go: UnmarshalText got %s, %v", g, err))
+	}
+
+	// no flag set round-trips as "", as any other value
+	for _, f := range []BitFlags{0, BitFlagsRead | BitFlagsSticky} {
+		ckRoundTrip(f)
+	}
+}
+
+func ckRoundTrip(f BitFlags) {
+	var got BitFlags
+	data, err := json.Marshal(f)
+	if err == nil {
+		got = BitFlagsSticky
+		err = json.Unmarshal(data, &got)
+	}
+	if err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: JSON of %d through %s got %d, %v", uint8(f), data, uint8(got), err))
+	}
+	text, err := f.MarshalText()
+	if err == nil {
+		got = BitFlagsSticky
+		err = got.UnmarshalText(text)
+	}
+	if err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: text of %d through %q got %d, %v", uint8(f), text, uint8(got), err))
+	}
+	binary, err := f.MarshalBinary()
+	if err == nil {
+		got = BitFlagsSticky
+		err = got.UnmarshalBinary(binary)
+	}
+	if err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: binary of %d through %q got %d, %v", uint8(f), binary, uint8(got), err))
+	}
+	value, err := f.Value()
+	if err == nil {
+		got = BitFlagsSticky
+		err = got.Scan(value)
+	}
+	if err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: SQL of %d through %v got %d, %v", uint8(f), value, uint8(got), err))
+	}
+	got = BitFlagsSticky
+	if err := got.Scan(int64(f)); err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: Scan(%d) got %d, %v", int64(f), uint8(got), err))
+	}
+	got = BitFlagsSticky
+	if err := got.Set(f.String()); err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: Set(%q) got %d, %v", f.String(), uint8(got), err))
+	}
+}
+
+func ck(f BitFlags, str string) {
+	ckString(f, str)
+	if got, err := ParseBitFlagsString(str); err != nil || got != f {
+		panic(fmt.Sprintf("BitFlags.go: ParseBitFlagsString(%q) got %d, %v, want %d", str, got, err, f))
+	}
+}
+
+func ckString(f BitFlags, str string) {
+	if got := f.String(); got != str {
+		panic(fmt.Sprintf("BitFlags.go: %d.String() got %q, want %q", uint8(f), got, str))
+	}
+}
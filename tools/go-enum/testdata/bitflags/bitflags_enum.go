@@ -0,0 +1,399 @@
+// Code generated by "go-enum -type BitFlags -trimprefix BitFlags -flags"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[BitFlagsRead-1]
+	_ = x[BitFlagsWrite-2]
+	_ = x[BitFlagsExec-4]
+	_ = x[BitFlagsSticky-16]
+}
+
+const (
+	_BitFlags_name_0 = "ReadWrite"
+	_BitFlags_name_1 = "Exec"
+	_BitFlags_name_2 = "Sticky"
+)
+
+var (
+	_BitFlags_index_0 = [...]uint8{0, 4, 9}
+)
+
+var _BitFlags_flags = []struct {
+	flag BitFlags
+	name string
+}{
+	{1, "Read"},
+	{2, "Write"},
+	{4, "Exec"},
+	{16, "Sticky"},
+}
+
+func _() {
+	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_BitFlags_value
+}
+
+// String returns the names of the flags set in i, joined by "|", in the order of their values,
+// followed by the bits left, if any, as BitFlags(bits), or "" if no bit is set.
+func (i BitFlags) String() string {
+	if i == 0 {
+		return ""
+	}
+	var names []string
+	rest := i
+	for _, f := range _BitFlags_flags {
+		if i&f.flag == f.flag {
+			names = append(names, f.name)
+			rest &^= f.flag
+		}
+	}
+	if rest != 0 {
+		names = append(names, "BitFlags("+strconv.FormatInt(int64(rest), 10)+")")
+	}
+	return strings.Join(names, "|")
+}
+
+// Has reports whether all the flags set in f are set in i.
+func (i BitFlags) Has(f BitFlags) bool {
+	return i&f == f
+}
+
+// SetFlag returns i with the flags set in f set.
+func (i BitFlags) SetFlag(f BitFlags) BitFlags {
+	return i | f
+}
+
+// ClearFlag returns i with the flags set in f cleared.
+func (i BitFlags) ClearFlag(f BitFlags) BitFlags {
+	return i &^ f
+}
+
+// New returns a pointer to a new addr filled with the BitFlags value passed in.
+func (i BitFlags) New() *BitFlags {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *BitFlags
+// to a constant, whose address cannot be taken.
+func (i BitFlags) Ptr() *BitFlags {
+	return &i
+}
+
+var _BitFlags_values = []BitFlags{1, 2, 4, 16}
+
+var _BitFlags_name_to_values = map[string]BitFlags{
+	_BitFlags_name_0[0:4]: 1,
+	_BitFlags_name_0[4:9]: 2,
+	_BitFlags_name_1[0:4]: 4,
+	_BitFlags_name_2[0:6]: 16,
+}
+
+// ParseBitFlagsString retrieves an enum value from the enum constants string name,
+// or from the names of the flags set in it joined by "|", as String returns, "" being no flag set.
+// Throws an error if any name is not part of the enum.
+func ParseBitFlagsString(s string) (BitFlags, error) {
+	if val, ok := _BitFlags_name_to_values[s]; ok {
+		return val, nil
+	}
+	if s == "" {
+		return 0, nil
+	}
+	var val BitFlags
+	for _, name := range strings.Split(s, "|") {
+		f, ok := _BitFlags_name_to_values[strings.TrimSpace(name)]
+		if !ok {
+			return 0, fmt.Errorf("%s does not belong to BitFlags values", s)
+		}
+		val |= f
+	}
+	return val, nil
+}
+
+// MustParseBitFlagsString is like ParseBitFlagsString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseBitFlagsString(s string) BitFlags {
+	val, err := ParseBitFlagsString(s)
+	if err != nil {
+		panic("MustParseBitFlagsString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// BitFlagsValues returns all values of the enum
+func BitFlagsValues() []BitFlags {
+	return _BitFlags_values
+}
+
+// Next returns the registered value of BitFlags following i, in the order of BitFlagsValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i BitFlags) Next() (BitFlags, bool) {
+	for _, v := range _BitFlags_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of BitFlags preceding i, in the order of BitFlagsValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i BitFlags) Prev() (BitFlags, bool) {
+	for j := len(_BitFlags_values) - 1; j >= 0; j-- {
+		if v := _BitFlags_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i BitFlags) Registered() bool {
+	for _, v := range _BitFlags_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_BitFlags_value
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_BitFlags_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for BitFlags
+func (i BitFlags) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for BitFlags
+func (i *BitFlags) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseBitFlagsString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_BitFlags_value
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_BitFlags_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for BitFlags
+func (i BitFlags) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for BitFlags
+func (i *BitFlags) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("BitFlags should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseBitFlagsString(s)
+	return err
+}
+
+func _() {
+	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_BitFlags_value
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_BitFlags_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for BitFlags
+func (i BitFlags) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for BitFlags
+func (i *BitFlags) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseBitFlagsString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+//
+//	// An "cannot convert BitFlags literal (type BitFlags) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_BitFlags_value
+//
+//	// An "cannot convert BitFlags literal (type BitFlags) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_BitFlags_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for BitFlags
+func (i BitFlags) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for BitFlags
+func (i *BitFlags) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseBitFlagsString(s)
+	return err
+}
+
+func _() {
+	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_BitFlags_value
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_BitFlags_value
+}
+
+func (i BitFlags) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for BitFlags, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of BitFlags.
+func (i *BitFlags) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val BitFlags
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseBitFlagsString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseBitFlagsString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = BitFlags(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = BitFlags(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = BitFlags(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val&^(1|2|4|16) == 0) {
+			return fmt.Errorf("%v does not belong to BitFlags values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_BitFlags_value = func() (val BitFlags) { return }()
+
+	// An "cannot convert BitFlags literal (type BitFlags) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_BitFlags_value
+}
+
+// Set implements the flag.Value interface for BitFlags, so that a BitFlags can be a command-line flag, by flag.Var.
+func (i *BitFlags) Set(s string) error {
+	v, err := ParseBitFlagsString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// BitFlagsSliceContains reports whether sunEnums is within enums.
+func BitFlagsSliceContains(enums []BitFlags, sunEnums ...BitFlags) bool {
+	var seenEnums = map[BitFlags]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// BitFlagsSliceContainsAny reports whether any sunEnum is within enums.
+func BitFlagsSliceContainsAny(enums []BitFlags, sunEnums ...BitFlags) bool {
+	var seenEnums = map[BitFlags]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
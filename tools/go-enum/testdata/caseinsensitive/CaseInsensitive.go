@@ -0,0 +1,50 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Names parsed ignoring case.
+
+package main
+
+import "fmt"
+
+//go:generate go-enum -type CaseInsensitive -trimprefix CaseInsensitive -ci
+type CaseInsensitive int
+
+const (
+	CaseInsensitiveNew CaseInsensitive = iota
+	CaseInsensitiveActive
+	CaseInsensitiveIdleTimeout
+	CaseInsensitiveClosed
+	CaseInsensitiveAlias = CaseInsensitiveActive
+)
+
+func main() {
+	ck(CaseInsensitiveNew, "New", "new", "NEW", "nEw")
+	ck(CaseInsensitiveActive, "Active", "active", "ACTIVE")
+	ck(CaseInsensitiveIdleTimeout, "IdleTimeout", "idletimeout", "IDLETIMEOUT", "idleTimeout")
+	ck(CaseInsensitiveClosed, "Closed", "closed")
+
+	for _, s := range []string{"", "idle", "CaseInsensitiveNew", "CaseInsensitive(1)", "active "} {
+		if _, err := ParseCaseInsensitiveStringCaseInsensitive(s); err == nil {
+			panic(fmt.Sprintf("CaseInsensitive.go: ParseCaseInsensitiveStringCaseInsensitive(%q) got no error", s))
+		}
+	}
+}
+
+func ck(c CaseInsensitive, name string, others ...string) {
+	if got, err := ParseCaseInsensitiveString(name); err != nil || got != c {
+		panic(fmt.Sprintf("CaseInsensitive.go: ParseCaseInsensitiveString(%q) got %d, %v, want %d", name, got, err, c))
+	}
+	for _, s := range append([]string{name}, others...) {
+		if got, err := ParseCaseInsensitiveStringCaseInsensitive(s); err != nil || got != c {
+			panic(fmt.Sprintf("CaseInsensitive.go: ParseCaseInsensitiveStringCaseInsensitive(%q) got %d, %v, want %d", s, got, err, c))
+		}
+	}
+	// the exact parser stays strict
+	for _, s := range others {
+		if _, err := ParseCaseInsensitiveString(s); err == nil {
+			panic(fmt.Sprintf("CaseInsensitive.go: ParseCaseInsensitiveString(%q) got no error", s))
+		}
+	}
+}
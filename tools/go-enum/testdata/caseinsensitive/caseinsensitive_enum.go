@@ -0,0 +1,366 @@
+// Code generated by "go-enum -type CaseInsensitive -trimprefix CaseInsensitive -ci"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CaseInsensitiveNew-0]
+	_ = x[CaseInsensitiveActive-1]
+	_ = x[CaseInsensitiveIdleTimeout-2]
+	_ = x[CaseInsensitiveClosed-3]
+}
+
+const _CaseInsensitive_name = "NewActiveIdleTimeoutClosed"
+
+var _CaseInsensitive_index = [...]uint8{0, 3, 9, 20, 26}
+
+func _() {
+	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_CaseInsensitive_value
+}
+
+func (i CaseInsensitive) String() string {
+	if i < 0 || i >= CaseInsensitive(len(_CaseInsensitive_index)-1) {
+		return "CaseInsensitive(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _CaseInsensitive_name[_CaseInsensitive_index[i]:_CaseInsensitive_index[i+1]]
+}
+
+// New returns a pointer to a new addr filled with the CaseInsensitive value passed in.
+func (i CaseInsensitive) New() *CaseInsensitive {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *CaseInsensitive
+// to a constant, whose address cannot be taken.
+func (i CaseInsensitive) Ptr() *CaseInsensitive {
+	return &i
+}
+
+var _CaseInsensitive_values = []CaseInsensitive{0, 1, 2, 3}
+
+var _CaseInsensitive_name_to_values = map[string]CaseInsensitive{
+	_CaseInsensitive_name[0:3]:   0,
+	_CaseInsensitive_name[3:9]:   1,
+	_CaseInsensitive_name[9:20]:  2,
+	_CaseInsensitive_name[20:26]: 3,
+}
+
+// ParseCaseInsensitiveString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseCaseInsensitiveString(s string) (CaseInsensitive, error) {
+	if val, ok := _CaseInsensitive_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to CaseInsensitive values", s)
+}
+
+// MustParseCaseInsensitiveString is like ParseCaseInsensitiveString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseCaseInsensitiveString(s string) CaseInsensitive {
+	val, err := ParseCaseInsensitiveString(s)
+	if err != nil {
+		panic("MustParseCaseInsensitiveString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// CaseInsensitiveValues returns all values of the enum
+func CaseInsensitiveValues() []CaseInsensitive {
+	return _CaseInsensitive_values
+}
+
+// Next returns the registered value of CaseInsensitive following i, in the order of CaseInsensitiveValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i CaseInsensitive) Next() (CaseInsensitive, bool) {
+	for _, v := range _CaseInsensitive_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of CaseInsensitive preceding i, in the order of CaseInsensitiveValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i CaseInsensitive) Prev() (CaseInsensitive, bool) {
+	for j := len(_CaseInsensitive_values) - 1; j >= 0; j-- {
+		if v := _CaseInsensitive_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i CaseInsensitive) Registered() bool {
+	for _, v := range _CaseInsensitive_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_CaseInsensitive_value
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_CaseInsensitive_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for CaseInsensitive
+func (i CaseInsensitive) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for CaseInsensitive
+func (i *CaseInsensitive) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseCaseInsensitiveString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_CaseInsensitive_value
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_CaseInsensitive_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for CaseInsensitive
+func (i CaseInsensitive) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for CaseInsensitive
+func (i *CaseInsensitive) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("CaseInsensitive should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseCaseInsensitiveString(s)
+	return err
+}
+
+func _() {
+	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_CaseInsensitive_value
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_CaseInsensitive_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for CaseInsensitive
+func (i CaseInsensitive) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for CaseInsensitive
+func (i *CaseInsensitive) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseCaseInsensitiveString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+//
+//	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_CaseInsensitive_value
+//
+//	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_CaseInsensitive_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for CaseInsensitive
+func (i CaseInsensitive) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for CaseInsensitive
+func (i *CaseInsensitive) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseCaseInsensitiveString(s)
+	return err
+}
+
+func _() {
+	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_CaseInsensitive_value
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_CaseInsensitive_value
+}
+
+func (i CaseInsensitive) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for CaseInsensitive, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of CaseInsensitive.
+func (i *CaseInsensitive) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val CaseInsensitive
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseCaseInsensitiveString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseCaseInsensitiveString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = CaseInsensitive(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = CaseInsensitive(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = CaseInsensitive(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to CaseInsensitive values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_CaseInsensitive_value = func() (val CaseInsensitive) { return }()
+
+	// An "cannot convert CaseInsensitive literal (type CaseInsensitive) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_CaseInsensitive_value
+}
+
+// Set implements the flag.Value interface for CaseInsensitive, so that a CaseInsensitive can be a command-line flag, by flag.Var.
+func (i *CaseInsensitive) Set(s string) error {
+	v, err := ParseCaseInsensitiveString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// CaseInsensitiveSliceContains reports whether sunEnums is within enums.
+func CaseInsensitiveSliceContains(enums []CaseInsensitive, sunEnums ...CaseInsensitive) bool {
+	var seenEnums = map[CaseInsensitive]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CaseInsensitiveSliceContainsAny reports whether any sunEnum is within enums.
+func CaseInsensitiveSliceContainsAny(enums []CaseInsensitive, sunEnums ...CaseInsensitive) bool {
+	var seenEnums = map[CaseInsensitive]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _() {
+	// A "duplicate key" compiler error signifies that names of CaseInsensitive differ only in case.
+	// Rename the constants, or their line comments, and re-run the go-enum command.
+	_ = _CaseInsensitive_lower_name_to_values
+}
+
+var _CaseInsensitive_lower_name_to_values = map[string]CaseInsensitive{
+	"new":         0,
+	"active":      1,
+	"idletimeout": 2,
+	"closed":      3,
+}
+
+// ParseCaseInsensitiveStringCaseInsensitive retrieves an enum value from the enum constants string name,
+// ignoring case, so that "active" and "ACTIVE" both parse as "Active" does.
+// Throws an error if the param is not part of the enum, in any case.
+func ParseCaseInsensitiveStringCaseInsensitive(s string) (CaseInsensitive, error) {
+	if val, ok := _CaseInsensitive_lower_name_to_values[strings.ToLower(s)]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to CaseInsensitive values", s)
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Values round-tripped through CSV fields and gob.
+
+package main
+
+import (
+	"bytes"
+	"encoding/gob"
+	"fmt"
+)
+
+//go:generate go-enum -type CsvGob -trimprefix CsvGob -csv -gob
+type CsvGob int
+
+const (
+	CsvGobNew CsvGob = iota
+	CsvGobActive
+	CsvGobIdle
+	CsvGobClosed CsvGob = 10
+)
+
+type Conn struct {
+	ID    int
+	State CsvGob
+	Prev  *CsvGob
+}
+
+func main() {
+	for _, s := range CsvGobValues() {
+		ckCsv(s)
+		ckGob(s)
+	}
+
+	var s CsvGob
+	for _, field := range []string{"", "active", "CsvGobActive", "CsvGob(3)"} {
+		if err := s.UnmarshalCSV(field); err == nil {
+			panic(fmt.Sprintf("CsvGob.go: UnmarshalCSV(%q) got no error", field))
+		}
+	}
+	if err := s.GobDecode([]byte("Unknown")); err == nil {
+		panic("CsvGob.go: GobDecode(\"Unknown\") got no error")
+	}
+}
+
+func ckCsv(s CsvGob) {
+	field, err := s.MarshalCSV()
+	if err != nil || field != s.String() {
+		panic(fmt.Sprintf("CsvGob.go: %d.MarshalCSV() got %q, %v, want %q", s, field, err, s.String()))
+	}
+	var got CsvGob
+	if err := got.UnmarshalCSV(field); err != nil || got != s {
+		panic(fmt.Sprintf("CsvGob.go: UnmarshalCSV(%q) got %d, %v, want %d", field, got, err, s))
+	}
+}
+
+func ckGob(s CsvGob) {
+	prev := CsvGobClosed
+	conn := Conn{ID: 1, State: s, Prev: &prev}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(conn); err != nil {
+		panic(fmt.Sprintf("CsvGob.go: gob encode %s: %s", s, err))
+	}
+	if !bytes.Contains(buf.Bytes(), []byte(prev.String())) {
+		panic(fmt.Sprintf("CsvGob.go: gob encoded %s not by its name: %q", prev, buf.Bytes()))
+	}
+	var got Conn
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		panic(fmt.Sprintf("CsvGob.go: gob decode %s: %s", s, err))
+	}
+	if got.ID != conn.ID || got.State != s || got.Prev == nil || *got.Prev != prev {
+		panic(fmt.Sprintf("CsvGob.go: gob round-tripped %+v, want %+v", got, conn))
+	}
+}
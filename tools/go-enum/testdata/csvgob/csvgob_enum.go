@@ -0,0 +1,381 @@
+// Code generated by "go-enum -type CsvGob -trimprefix CsvGob -csv -gob"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/gob"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[CsvGobNew-0]
+	_ = x[CsvGobActive-1]
+	_ = x[CsvGobIdle-2]
+	_ = x[CsvGobClosed-10]
+}
+
+const (
+	_CsvGob_name_0 = "NewActiveIdle"
+	_CsvGob_name_1 = "Closed"
+)
+
+var (
+	_CsvGob_index_0 = [...]uint8{0, 3, 9, 13}
+)
+
+func (i CsvGob) String() string {
+	switch {
+	case 0 <= i && i <= 2:
+		return _CsvGob_name_0[_CsvGob_index_0[i]:_CsvGob_index_0[i+1]]
+	case i == 10:
+		return _CsvGob_name_1
+	default:
+		return "CsvGob(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// New returns a pointer to a new addr filled with the CsvGob value passed in.
+func (i CsvGob) New() *CsvGob {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *CsvGob
+// to a constant, whose address cannot be taken.
+func (i CsvGob) Ptr() *CsvGob {
+	return &i
+}
+
+var _CsvGob_values = []CsvGob{0, 1, 2, 10}
+
+var _CsvGob_name_to_values = map[string]CsvGob{
+	_CsvGob_name_0[0:3]:  0,
+	_CsvGob_name_0[3:9]:  1,
+	_CsvGob_name_0[9:13]: 2,
+	_CsvGob_name_1[0:6]:  10,
+}
+
+// ParseCsvGobString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseCsvGobString(s string) (CsvGob, error) {
+	if val, ok := _CsvGob_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to CsvGob values", s)
+}
+
+// MustParseCsvGobString is like ParseCsvGobString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseCsvGobString(s string) CsvGob {
+	val, err := ParseCsvGobString(s)
+	if err != nil {
+		panic("MustParseCsvGobString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// CsvGobValues returns all values of the enum
+func CsvGobValues() []CsvGob {
+	return _CsvGob_values
+}
+
+// Next returns the registered value of CsvGob following i, in the order of CsvGobValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i CsvGob) Next() (CsvGob, bool) {
+	for _, v := range _CsvGob_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of CsvGob preceding i, in the order of CsvGobValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i CsvGob) Prev() (CsvGob, bool) {
+	for j := len(_CsvGob_values) - 1; j >= 0; j-- {
+		if v := _CsvGob_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i CsvGob) Registered() bool {
+	for _, v := range _CsvGob_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_CsvGob_value
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_CsvGob_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for CsvGob
+func (i CsvGob) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for CsvGob
+func (i *CsvGob) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseCsvGobString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_CsvGob_value
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_CsvGob_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for CsvGob
+func (i CsvGob) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for CsvGob
+func (i *CsvGob) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("CsvGob should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseCsvGobString(s)
+	return err
+}
+
+func _() {
+	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_CsvGob_value
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_CsvGob_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for CsvGob
+func (i CsvGob) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for CsvGob
+func (i *CsvGob) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseCsvGobString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+//
+//	// An "cannot convert CsvGob literal (type CsvGob) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_CsvGob_value
+//
+//	// An "cannot convert CsvGob literal (type CsvGob) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_CsvGob_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for CsvGob
+func (i CsvGob) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for CsvGob
+func (i *CsvGob) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseCsvGobString(s)
+	return err
+}
+
+func _() {
+	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_CsvGob_value
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_CsvGob_value
+}
+
+func (i CsvGob) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for CsvGob, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of CsvGob.
+func (i *CsvGob) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val CsvGob
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseCsvGobString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseCsvGobString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = CsvGob(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = CsvGob(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = CsvGob(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to CsvGob values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_CsvGob_value
+}
+
+// Set implements the flag.Value interface for CsvGob, so that a CsvGob can be a command-line flag, by flag.Var.
+func (i *CsvGob) Set(s string) error {
+	v, err := ParseCsvGobString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// MarshalCSV marshals CsvGob as a field of CSV, as github.com/gocarina/gocsv.TypeMarshaller does
+func (i CsvGob) MarshalCSV() (string, error) {
+	return i.String(), nil
+}
+
+// UnmarshalCSV unmarshals CsvGob from a field of CSV, as github.com/gocarina/gocsv.TypeUnmarshaller does
+func (i *CsvGob) UnmarshalCSV(s string) error {
+	var err error
+	*i, err = ParseCsvGobString(s)
+	return err
+}
+
+func _() {
+	var _nil_CsvGob_value = func() (val CsvGob) { return }()
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type gob.GobEncoder" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ gob.GobEncoder = _nil_CsvGob_value
+
+	// An "cannot convert CsvGob literal (type CsvGob) to type gob.GobDecoder" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ gob.GobDecoder = &_nil_CsvGob_value
+}
+
+// GobEncode implements the encoding/gob.GobEncoder interface for CsvGob,
+// encoding i by its name, as MarshalBinary does
+func (i CsvGob) GobEncode() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// GobDecode implements the encoding/gob.GobDecoder interface for CsvGob
+func (i *CsvGob) GobDecode(data []byte) error {
+	var err error
+	*i, err = ParseCsvGobString(string(data))
+	return err
+}
+
+// CsvGobSliceContains reports whether sunEnums is within enums.
+func CsvGobSliceContains(enums []CsvGob, sunEnums ...CsvGob) bool {
+	var seenEnums = map[CsvGob]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// CsvGobSliceContainsAny reports whether any sunEnum is within enums.
+func CsvGobSliceContainsAny(enums []CsvGob, sunEnums ...CsvGob) bool {
+	var seenEnums = map[CsvGob]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
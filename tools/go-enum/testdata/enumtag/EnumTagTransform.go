@@ -0,0 +1,41 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Custom names from enum tags in line comments, without -linecomment, not transformed.
+
+package main
+
+import "fmt"
+
+//go:generate go-enum -type EnumTagTransform -trimprefix EnumTagTransform -transform lower
+type EnumTagTransform int
+
+const (
+	EnumTagTransformNew    EnumTagTransform = iota // enum:"New_Connection"
+	EnumTagTransformActive                         // an ordinary comment, not a name
+	EnumTagTransformIdle                           // enum:"IDLE" json:"idle"
+	EnumTagTransformClosed
+)
+
+func main() {
+	ck(EnumTagTransformNew, "New_Connection")
+	ck(EnumTagTransformActive, "active")
+	ck(EnumTagTransformIdle, "IDLE")
+	ck(EnumTagTransformClosed, "closed")
+
+	for _, s := range []string{"new_connection", "new", "idle", "an ordinary comment, not a name"} {
+		if _, err := ParseEnumTagTransformString(s); err == nil {
+			panic(fmt.Sprintf("EnumTagTransform.go: ParseEnumTagTransformString(%q) got no error", s))
+		}
+	}
+}
+
+func ck(e EnumTagTransform, str string) {
+	if got := e.String(); got != str {
+		panic(fmt.Sprintf("EnumTagTransform.go: %d.String() got %q, want %q", int(e), got, str))
+	}
+	if got, err := ParseEnumTagTransformString(e.String()); err != nil || got != e {
+		panic(fmt.Sprintf("EnumTagTransform.go: ParseEnumTagTransformString(%q) got %d, %v, want %d", str, got, err, e))
+	}
+}
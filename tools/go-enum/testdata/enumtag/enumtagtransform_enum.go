@@ -0,0 +1,342 @@
+// Code generated by "go-enum -type EnumTagTransform -trimprefix EnumTagTransform -transform lower"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[EnumTagTransformNew-0]
+	_ = x[EnumTagTransformActive-1]
+	_ = x[EnumTagTransformIdle-2]
+	_ = x[EnumTagTransformClosed-3]
+}
+
+const _EnumTagTransform_name = "New_ConnectionactiveIDLEclosed"
+
+var _EnumTagTransform_index = [...]uint8{0, 14, 20, 24, 30}
+
+func _() {
+	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_EnumTagTransform_value
+}
+
+func (i EnumTagTransform) String() string {
+	if i < 0 || i >= EnumTagTransform(len(_EnumTagTransform_index)-1) {
+		return "EnumTagTransform(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _EnumTagTransform_name[_EnumTagTransform_index[i]:_EnumTagTransform_index[i+1]]
+}
+
+// New returns a pointer to a new addr filled with the EnumTagTransform value passed in.
+func (i EnumTagTransform) New() *EnumTagTransform {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *EnumTagTransform
+// to a constant, whose address cannot be taken.
+func (i EnumTagTransform) Ptr() *EnumTagTransform {
+	return &i
+}
+
+var _EnumTagTransform_values = []EnumTagTransform{0, 1, 2, 3}
+
+var _EnumTagTransform_name_to_values = map[string]EnumTagTransform{
+	_EnumTagTransform_name[0:14]:  0,
+	_EnumTagTransform_name[14:20]: 1,
+	_EnumTagTransform_name[20:24]: 2,
+	_EnumTagTransform_name[24:30]: 3,
+}
+
+// ParseEnumTagTransformString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseEnumTagTransformString(s string) (EnumTagTransform, error) {
+	if val, ok := _EnumTagTransform_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to EnumTagTransform values", s)
+}
+
+// MustParseEnumTagTransformString is like ParseEnumTagTransformString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseEnumTagTransformString(s string) EnumTagTransform {
+	val, err := ParseEnumTagTransformString(s)
+	if err != nil {
+		panic("MustParseEnumTagTransformString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// EnumTagTransformValues returns all values of the enum
+func EnumTagTransformValues() []EnumTagTransform {
+	return _EnumTagTransform_values
+}
+
+// Next returns the registered value of EnumTagTransform following i, in the order of EnumTagTransformValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i EnumTagTransform) Next() (EnumTagTransform, bool) {
+	for _, v := range _EnumTagTransform_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of EnumTagTransform preceding i, in the order of EnumTagTransformValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i EnumTagTransform) Prev() (EnumTagTransform, bool) {
+	for j := len(_EnumTagTransform_values) - 1; j >= 0; j-- {
+		if v := _EnumTagTransform_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i EnumTagTransform) Registered() bool {
+	for _, v := range _EnumTagTransform_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_EnumTagTransform_value
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_EnumTagTransform_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for EnumTagTransform
+func (i EnumTagTransform) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for EnumTagTransform
+func (i *EnumTagTransform) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseEnumTagTransformString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_EnumTagTransform_value
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_EnumTagTransform_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for EnumTagTransform
+func (i EnumTagTransform) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for EnumTagTransform
+func (i *EnumTagTransform) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("EnumTagTransform should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseEnumTagTransformString(s)
+	return err
+}
+
+func _() {
+	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_EnumTagTransform_value
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_EnumTagTransform_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for EnumTagTransform
+func (i EnumTagTransform) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for EnumTagTransform
+func (i *EnumTagTransform) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseEnumTagTransformString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+//
+//	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_EnumTagTransform_value
+//
+//	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_EnumTagTransform_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for EnumTagTransform
+func (i EnumTagTransform) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for EnumTagTransform
+func (i *EnumTagTransform) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseEnumTagTransformString(s)
+	return err
+}
+
+func _() {
+	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_EnumTagTransform_value
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_EnumTagTransform_value
+}
+
+func (i EnumTagTransform) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for EnumTagTransform, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of EnumTagTransform.
+func (i *EnumTagTransform) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val EnumTagTransform
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseEnumTagTransformString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseEnumTagTransformString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = EnumTagTransform(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = EnumTagTransform(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = EnumTagTransform(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to EnumTagTransform values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_EnumTagTransform_value = func() (val EnumTagTransform) { return }()
+
+	// An "cannot convert EnumTagTransform literal (type EnumTagTransform) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_EnumTagTransform_value
+}
+
+// Set implements the flag.Value interface for EnumTagTransform, so that a EnumTagTransform can be a command-line flag, by flag.Var.
+func (i *EnumTagTransform) Set(s string) error {
+	v, err := ParseEnumTagTransformString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// EnumTagTransformSliceContains reports whether sunEnums is within enums.
+func EnumTagTransformSliceContains(enums []EnumTagTransform, sunEnums ...EnumTagTransform) bool {
+	var seenEnums = map[EnumTagTransform]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EnumTagTransformSliceContainsAny reports whether any sunEnum is within enums.
+func EnumTagTransformSliceContainsAny(enums []EnumTagTransform, sunEnums ...EnumTagTransform) bool {
+	var seenEnums = map[EnumTagTransform]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,98 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Enumeration with explicit, non-contiguous values.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//go:generate go-enum -type "Status" -trimprefix "Status"
+type Status int
+
+const (
+	StatusOK                  Status = 200
+	StatusCreated             Status = 201
+	StatusNoContent           Status = 204
+	StatusMovedPermanently    Status = 301
+	StatusNotFound            Status = 404
+	StatusInternalServerError Status = 500
+	StatusServiceUnavailable  Status = 503
+	StatusSuccess                    = StatusOK // Duplicate; named OK.
+)
+
+// statusDefault is initialized at init, parsed from a name known to be valid.
+var statusDefault = MustParseStatusString("OK")
+
+func main() {
+	for _, tt := range []struct {
+		s    Status
+		name string
+	}{
+		{StatusOK, "OK"},
+		{StatusCreated, "Created"},
+		{StatusNoContent, "NoContent"},
+		{StatusMovedPermanently, "MovedPermanently"},
+		{StatusNotFound, "NotFound"},
+		{StatusInternalServerError, "InternalServerError"},
+		{StatusServiceUnavailable, "ServiceUnavailable"},
+		{StatusSuccess, "OK"},
+	} {
+		ck(tt.s, tt.name, true)
+	}
+	for _, s := range []Status{0, 1, 199, 202, 203, 205, 300, 302, 403, 405, 501, 502, 504} {
+		ck(s, fmt.Sprintf("Status(%d)", int(s)), false)
+	}
+	if got := len(StatusValues()); got != 7 {
+		panic(fmt.Sprintf("Status.go: got %d values, expect 7", got))
+	}
+	type response struct {
+		Status *Status
+	}
+	ok, created := response{Status: StatusOK.Ptr()}, response{Status: StatusCreated.Ptr()}
+	*ok.Status = StatusNotFound
+	if *created.Status != StatusCreated || StatusOK.Ptr() == StatusOK.Ptr() {
+		panic(fmt.Sprintf("Status.go: Ptr() got %s, expect a pointer to a copy", *created.Status))
+	}
+	if statusDefault != StatusSuccess {
+		panic(fmt.Sprintf("Status.go: MustParseStatusString(%q) got %s, expect %s", "OK", statusDefault, StatusSuccess))
+	}
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				panic(fmt.Sprintf("Status.go: MustParseStatusString(%q) did not panic", "Success"))
+			}
+		}()
+		MustParseStatusString("Success")
+	}()
+}
+
+func ck(s Status, name string, registered bool) {
+	if s.String() != name {
+		panic(fmt.Sprintf("Status.go: got %s, expect %s", s.String(), name))
+	}
+	if s.Registered() != registered {
+		panic(fmt.Sprintf("Status.go: %d registered %t, expect %t", int(s), s.Registered(), registered))
+	}
+	if !registered {
+		if _, err := ParseStatusString(name); err == nil {
+			panic(fmt.Sprintf("Status.go: parsed %s, expect error", name))
+		}
+		return
+	}
+	if got, err := ParseStatusString(name); err != nil || got != s {
+		panic(fmt.Sprintf("Status.go: parsed %s as %d, %v, expect %d", name, int(got), err, int(s)))
+	}
+	b, err := json.Marshal(s)
+	if err != nil || string(b) != fmt.Sprintf("%q", name) {
+		panic(fmt.Sprintf("Status.go: json.Marshal got %s, %v, expect %q", b, err, name))
+	}
+	var got Status
+	if err := json.Unmarshal(b, &got); err != nil || got != s {
+		panic(fmt.Sprintf("Status.go: json.Unmarshal got %d, %v, expect %d", int(got), err, int(s)))
+	}
+}
@@ -0,0 +1,362 @@
+// Code generated by "go-enum -type Status -trimprefix Status"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StatusOK-200]
+	_ = x[StatusCreated-201]
+	_ = x[StatusNoContent-204]
+	_ = x[StatusMovedPermanently-301]
+	_ = x[StatusNotFound-404]
+	_ = x[StatusInternalServerError-500]
+	_ = x[StatusServiceUnavailable-503]
+}
+
+const (
+	_Status_name_0 = "OKCreated"
+	_Status_name_1 = "NoContent"
+	_Status_name_2 = "MovedPermanently"
+	_Status_name_3 = "NotFound"
+	_Status_name_4 = "InternalServerError"
+	_Status_name_5 = "ServiceUnavailable"
+)
+
+var (
+	_Status_index_0 = [...]uint8{0, 2, 9}
+)
+
+func (i Status) String() string {
+	switch {
+	case 200 <= i && i <= 201:
+		i -= 200
+		return _Status_name_0[_Status_index_0[i]:_Status_index_0[i+1]]
+	case i == 204:
+		return _Status_name_1
+	case i == 301:
+		return _Status_name_2
+	case i == 404:
+		return _Status_name_3
+	case i == 500:
+		return _Status_name_4
+	case i == 503:
+		return _Status_name_5
+	default:
+		return "Status(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// New returns a pointer to a new addr filled with the Status value passed in.
+func (i Status) New() *Status {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *Status
+// to a constant, whose address cannot be taken.
+func (i Status) Ptr() *Status {
+	return &i
+}
+
+var _Status_values = []Status{200, 201, 204, 301, 404, 500, 503}
+
+var _Status_name_to_values = map[string]Status{
+	_Status_name_0[0:2]:  200,
+	_Status_name_0[2:9]:  201,
+	_Status_name_1[0:9]:  204,
+	_Status_name_2[0:16]: 301,
+	_Status_name_3[0:8]:  404,
+	_Status_name_4[0:19]: 500,
+	_Status_name_5[0:18]: 503,
+}
+
+// ParseStatusString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseStatusString(s string) (Status, error) {
+	if val, ok := _Status_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Status values", s)
+}
+
+// MustParseStatusString is like ParseStatusString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseStatusString(s string) Status {
+	val, err := ParseStatusString(s)
+	if err != nil {
+		panic("MustParseStatusString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// StatusValues returns all values of the enum
+func StatusValues() []Status {
+	return _Status_values
+}
+
+// Next returns the registered value of Status following i, in the order of StatusValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i Status) Next() (Status, bool) {
+	for _, v := range _Status_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of Status preceding i, in the order of StatusValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i Status) Prev() (Status, bool) {
+	for j := len(_Status_values) - 1; j >= 0; j-- {
+		if v := _Status_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Status) Registered() bool {
+	for _, v := range _Status_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_Status_value = func() (val Status) { return }()
+
+	// An "cannot convert Status literal (type Status) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_Status_value
+
+	// An "cannot convert Status literal (type Status) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_Status_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Status
+func (i Status) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Status
+func (i *Status) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseStatusString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_Status_value = func() (val Status) { return }()
+
+	// An "cannot convert Status literal (type Status) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_Status_value
+
+	// An "cannot convert Status literal (type Status) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_Status_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for Status
+func (i Status) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Status
+func (i *Status) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Status should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseStatusString(s)
+	return err
+}
+
+func _() {
+	var _nil_Status_value = func() (val Status) { return }()
+
+	// An "cannot convert Status literal (type Status) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_Status_value
+
+	// An "cannot convert Status literal (type Status) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_Status_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Status
+func (i Status) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Status
+func (i *Status) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseStatusString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_Status_value = func() (val Status) { return }()
+//
+//	// An "cannot convert Status literal (type Status) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_Status_value
+//
+//	// An "cannot convert Status literal (type Status) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_Status_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for Status
+func (i Status) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for Status
+func (i *Status) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseStatusString(s)
+	return err
+}
+
+func _() {
+	var _nil_Status_value = func() (val Status) { return }()
+
+	// An "cannot convert Status literal (type Status) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_Status_value
+
+	// An "cannot convert Status literal (type Status) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_Status_value
+}
+
+func (i Status) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for Status, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of Status.
+func (i *Status) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Status
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseStatusString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseStatusString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = Status(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = Status(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = Status(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to Status values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_Status_value = func() (val Status) { return }()
+
+	// An "cannot convert Status literal (type Status) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_Status_value
+}
+
+// Set implements the flag.Value interface for Status, so that a Status can be a command-line flag, by flag.Var.
+func (i *Status) Set(s string) error {
+	v, err := ParseStatusString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// StatusSliceContains reports whether sunEnums is within enums.
+func StatusSliceContains(enums []Status, sunEnums ...Status) bool {
+	var seenEnums = map[Status]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// StatusSliceContainsAny reports whether any sunEnum is within enums.
+func StatusSliceContainsAny(enums []Status, sunEnums ...Status) bool {
+	var seenEnums = map[Status]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
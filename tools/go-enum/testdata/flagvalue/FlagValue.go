@@ -0,0 +1,61 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Values set as command-line flags, by flag.Var.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+)
+
+//go:generate go-enum -type FlagValue -trimprefix FlagValue
+type FlagValue int
+
+const (
+	FlagValueNew FlagValue = iota
+	FlagValueActive
+	FlagValueIdle
+	FlagValueClosed
+)
+
+func main() {
+	ck([]string{}, FlagValueIdle)
+	ck([]string{"-state", "Active"}, FlagValueActive)
+	ck([]string{"-state=Closed"}, FlagValueClosed)
+	ck([]string{"-state", "New"}, FlagValueNew)
+
+	for _, arg := range []string{"active", "FlagValueActive", "FlagValue(1)", "1", ""} {
+		fs, state := newFlagSet()
+		if err := fs.Parse([]string{"-state", arg}); err == nil {
+			panic(fmt.Sprintf("FlagValue.go: -state %q got %s, want an error", arg, *state))
+		}
+		if *state != FlagValueIdle {
+			panic(fmt.Sprintf("FlagValue.go: -state %q changed the flag to %s on error", arg, *state))
+		}
+	}
+}
+
+func newFlagSet() (*flag.FlagSet, *FlagValue) {
+	fs := flag.NewFlagSet("FlagValue", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	state := FlagValueIdle
+	fs.Var(&state, "state", "the state")
+	return fs, &state
+}
+
+func ck(args []string, want FlagValue) {
+	fs, state := newFlagSet()
+	if err := fs.Parse(args); err != nil {
+		panic(fmt.Sprintf("FlagValue.go: %q: %s", args, err))
+	}
+	if *state != want {
+		panic(fmt.Sprintf("FlagValue.go: %q got %s, want %s", args, *state, want))
+	}
+	if got := fs.Lookup("state").Value.String(); got != want.String() {
+		panic(fmt.Sprintf("FlagValue.go: %q flag printed %q, want %q", args, got, want.String()))
+	}
+}
@@ -0,0 +1,342 @@
+// Code generated by "go-enum -type FlagValue -trimprefix FlagValue"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[FlagValueNew-0]
+	_ = x[FlagValueActive-1]
+	_ = x[FlagValueIdle-2]
+	_ = x[FlagValueClosed-3]
+}
+
+const _FlagValue_name = "NewActiveIdleClosed"
+
+var _FlagValue_index = [...]uint8{0, 3, 9, 13, 19}
+
+func _() {
+	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_FlagValue_value
+}
+
+func (i FlagValue) String() string {
+	if i < 0 || i >= FlagValue(len(_FlagValue_index)-1) {
+		return "FlagValue(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _FlagValue_name[_FlagValue_index[i]:_FlagValue_index[i+1]]
+}
+
+// New returns a pointer to a new addr filled with the FlagValue value passed in.
+func (i FlagValue) New() *FlagValue {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *FlagValue
+// to a constant, whose address cannot be taken.
+func (i FlagValue) Ptr() *FlagValue {
+	return &i
+}
+
+var _FlagValue_values = []FlagValue{0, 1, 2, 3}
+
+var _FlagValue_name_to_values = map[string]FlagValue{
+	_FlagValue_name[0:3]:   0,
+	_FlagValue_name[3:9]:   1,
+	_FlagValue_name[9:13]:  2,
+	_FlagValue_name[13:19]: 3,
+}
+
+// ParseFlagValueString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseFlagValueString(s string) (FlagValue, error) {
+	if val, ok := _FlagValue_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to FlagValue values", s)
+}
+
+// MustParseFlagValueString is like ParseFlagValueString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseFlagValueString(s string) FlagValue {
+	val, err := ParseFlagValueString(s)
+	if err != nil {
+		panic("MustParseFlagValueString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// FlagValueValues returns all values of the enum
+func FlagValueValues() []FlagValue {
+	return _FlagValue_values
+}
+
+// Next returns the registered value of FlagValue following i, in the order of FlagValueValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i FlagValue) Next() (FlagValue, bool) {
+	for _, v := range _FlagValue_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of FlagValue preceding i, in the order of FlagValueValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i FlagValue) Prev() (FlagValue, bool) {
+	for j := len(_FlagValue_values) - 1; j >= 0; j-- {
+		if v := _FlagValue_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i FlagValue) Registered() bool {
+	for _, v := range _FlagValue_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_FlagValue_value
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_FlagValue_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for FlagValue
+func (i FlagValue) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for FlagValue
+func (i *FlagValue) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseFlagValueString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_FlagValue_value
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_FlagValue_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for FlagValue
+func (i FlagValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for FlagValue
+func (i *FlagValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("FlagValue should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseFlagValueString(s)
+	return err
+}
+
+func _() {
+	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_FlagValue_value
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_FlagValue_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for FlagValue
+func (i FlagValue) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for FlagValue
+func (i *FlagValue) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseFlagValueString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+//
+//	// An "cannot convert FlagValue literal (type FlagValue) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_FlagValue_value
+//
+//	// An "cannot convert FlagValue literal (type FlagValue) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_FlagValue_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for FlagValue
+func (i FlagValue) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for FlagValue
+func (i *FlagValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseFlagValueString(s)
+	return err
+}
+
+func _() {
+	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_FlagValue_value
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_FlagValue_value
+}
+
+func (i FlagValue) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for FlagValue, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of FlagValue.
+func (i *FlagValue) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val FlagValue
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseFlagValueString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseFlagValueString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = FlagValue(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = FlagValue(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = FlagValue(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to FlagValue values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_FlagValue_value = func() (val FlagValue) { return }()
+
+	// An "cannot convert FlagValue literal (type FlagValue) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_FlagValue_value
+}
+
+// Set implements the flag.Value interface for FlagValue, so that a FlagValue can be a command-line flag, by flag.Var.
+func (i *FlagValue) Set(s string) error {
+	v, err := ParseFlagValueString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// FlagValueSliceContains reports whether sunEnums is within enums.
+func FlagValueSliceContains(enums []FlagValue, sunEnums ...FlagValue) bool {
+	var seenEnums = map[FlagValue]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FlagValueSliceContainsAny reports whether any sunEnum is within enums.
+func FlagValueSliceContainsAny(enums []FlagValue, sunEnums ...FlagValue) bool {
+	var seenEnums = map[FlagValue]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,41 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Iterating the values of an enumeration with gaps.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+)
+
+//go:generate go-enum -type "IterDay" -trimprefix "IterDay" -iter
+type IterDay int
+
+const (
+	IterDayMonday IterDay = iota + 1
+	IterDayTuesday
+	IterDayWednesday
+	IterDaySaturday IterDay = 6
+	IterDaySunday   IterDay = 7
+	IterDayWeekend          = IterDaySaturday // Duplicate; iterated once.
+)
+
+func main() {
+	var seq []IterDay
+	for d := range IterDaySeq() {
+		seq = append(seq, d)
+	}
+	want := []IterDay{IterDayMonday, IterDayTuesday, IterDayWednesday, IterDaySaturday, IterDaySunday}
+	if !slices.Equal(seq, want) || !slices.Equal(seq, IterDayValues()) {
+		panic(fmt.Sprintf("IterDay.go: IterDaySeq() got %v, expect %v", seq, want))
+	}
+	for d := range IterDaySeq() {
+		if d != IterDayMonday {
+			panic(fmt.Sprintf("IterDay.go: IterDaySeq() got %s first, expect %s", d, IterDayMonday))
+		}
+		break
+	}
+}
@@ -0,0 +1,355 @@
+// Code generated by "go-enum -type IterDay -trimprefix IterDay -iter"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"iter"
+	"reflect"
+	"slices"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[IterDayMonday-1]
+	_ = x[IterDayTuesday-2]
+	_ = x[IterDayWednesday-3]
+	_ = x[IterDaySaturday-6]
+	_ = x[IterDaySunday-7]
+}
+
+const (
+	_IterDay_name_0 = "MondayTuesdayWednesday"
+	_IterDay_name_1 = "SaturdaySunday"
+)
+
+var (
+	_IterDay_index_0 = [...]uint8{0, 6, 13, 22}
+	_IterDay_index_1 = [...]uint8{0, 8, 14}
+)
+
+func (i IterDay) String() string {
+	switch {
+	case 1 <= i && i <= 3:
+		i -= 1
+		return _IterDay_name_0[_IterDay_index_0[i]:_IterDay_index_0[i+1]]
+	case 6 <= i && i <= 7:
+		i -= 6
+		return _IterDay_name_1[_IterDay_index_1[i]:_IterDay_index_1[i+1]]
+	default:
+		return "IterDay(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// New returns a pointer to a new addr filled with the IterDay value passed in.
+func (i IterDay) New() *IterDay {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *IterDay
+// to a constant, whose address cannot be taken.
+func (i IterDay) Ptr() *IterDay {
+	return &i
+}
+
+var _IterDay_values = []IterDay{1, 2, 3, 6, 7}
+
+var _IterDay_name_to_values = map[string]IterDay{
+	_IterDay_name_0[0:6]:   1,
+	_IterDay_name_0[6:13]:  2,
+	_IterDay_name_0[13:22]: 3,
+	_IterDay_name_1[0:8]:   6,
+	_IterDay_name_1[8:14]:  7,
+}
+
+// ParseIterDayString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseIterDayString(s string) (IterDay, error) {
+	if val, ok := _IterDay_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to IterDay values", s)
+}
+
+// MustParseIterDayString is like ParseIterDayString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseIterDayString(s string) IterDay {
+	val, err := ParseIterDayString(s)
+	if err != nil {
+		panic("MustParseIterDayString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// IterDayValues returns all values of the enum
+func IterDayValues() []IterDay {
+	return _IterDay_values
+}
+
+// IterDaySeq returns an iterator over all values of the enum, in the order of IterDayValues.
+func IterDaySeq() iter.Seq[IterDay] {
+	return slices.Values(_IterDay_values)
+}
+
+// Next returns the registered value of IterDay following i, in the order of IterDayValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i IterDay) Next() (IterDay, bool) {
+	for _, v := range _IterDay_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of IterDay preceding i, in the order of IterDayValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i IterDay) Prev() (IterDay, bool) {
+	for j := len(_IterDay_values) - 1; j >= 0; j-- {
+		if v := _IterDay_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i IterDay) Registered() bool {
+	for _, v := range _IterDay_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_IterDay_value = func() (val IterDay) { return }()
+
+	// An "cannot convert IterDay literal (type IterDay) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_IterDay_value
+
+	// An "cannot convert IterDay literal (type IterDay) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_IterDay_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for IterDay
+func (i IterDay) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for IterDay
+func (i *IterDay) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseIterDayString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_IterDay_value = func() (val IterDay) { return }()
+
+	// An "cannot convert IterDay literal (type IterDay) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_IterDay_value
+
+	// An "cannot convert IterDay literal (type IterDay) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_IterDay_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for IterDay
+func (i IterDay) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for IterDay
+func (i *IterDay) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("IterDay should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseIterDayString(s)
+	return err
+}
+
+func _() {
+	var _nil_IterDay_value = func() (val IterDay) { return }()
+
+	// An "cannot convert IterDay literal (type IterDay) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_IterDay_value
+
+	// An "cannot convert IterDay literal (type IterDay) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_IterDay_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for IterDay
+func (i IterDay) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for IterDay
+func (i *IterDay) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseIterDayString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_IterDay_value = func() (val IterDay) { return }()
+//
+//	// An "cannot convert IterDay literal (type IterDay) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_IterDay_value
+//
+//	// An "cannot convert IterDay literal (type IterDay) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_IterDay_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for IterDay
+func (i IterDay) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for IterDay
+func (i *IterDay) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseIterDayString(s)
+	return err
+}
+
+func _() {
+	var _nil_IterDay_value = func() (val IterDay) { return }()
+
+	// An "cannot convert IterDay literal (type IterDay) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_IterDay_value
+
+	// An "cannot convert IterDay literal (type IterDay) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_IterDay_value
+}
+
+func (i IterDay) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for IterDay, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of IterDay.
+func (i *IterDay) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val IterDay
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseIterDayString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseIterDayString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = IterDay(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = IterDay(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = IterDay(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to IterDay values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_IterDay_value = func() (val IterDay) { return }()
+
+	// An "cannot convert IterDay literal (type IterDay) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_IterDay_value
+}
+
+// Set implements the flag.Value interface for IterDay, so that a IterDay can be a command-line flag, by flag.Var.
+func (i *IterDay) Set(s string) error {
+	v, err := ParseIterDayString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// IterDaySliceContains reports whether sunEnums is within enums.
+func IterDaySliceContains(enums []IterDay, sunEnums ...IterDay) bool {
+	var seenEnums = map[IterDay]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IterDaySliceContainsAny reports whether any sunEnum is within enums.
+func IterDaySliceContainsAny(enums []IterDay, sunEnums ...IterDay) bool {
+	var seenEnums = map[IterDay]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
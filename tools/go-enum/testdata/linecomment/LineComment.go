@@ -0,0 +1,67 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Custom names from line comments, round-tripped by all the marshalers.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//go:generate go-enum -type LineComment -trimprefix LineComment -linecomment
+type LineComment int
+
+const (
+	LineCommentNew    LineComment = iota // new_connection
+	LineCommentActive                    // enum:"active_connection"
+	LineCommentIdle                      //
+	LineCommentClosed
+	LineCommentHijacked // hijacked by the handler
+)
+
+func main() {
+	ck(LineCommentNew, "new_connection")
+	ck(LineCommentActive, "active_connection")
+	ck(LineCommentIdle, "Idle")
+	ck(LineCommentClosed, "Closed")
+	ck(LineCommentHijacked, "hijacked by the handler")
+
+	for _, s := range []string{"New", "new", "LineCommentNew", "Active", ""} {
+		if _, err := ParseLineCommentString(s); err == nil {
+			panic(fmt.Sprintf("LineComment.go: ParseLineCommentString(%q) got no error", s))
+		}
+	}
+}
+
+func ck(l LineComment, str string) {
+	if got := l.String(); got != str {
+		panic(fmt.Sprintf("LineComment.go: %d.String() got %q, want %q", int(l), got, str))
+	}
+	if got, err := ParseLineCommentString(str); err != nil || got != l {
+		panic(fmt.Sprintf("LineComment.go: ParseLineCommentString(%q) got %d, %v, want %d", str, got, err, l))
+	}
+
+	b, err := json.Marshal(l)
+	if err != nil || string(b) != fmt.Sprintf("%q", str) {
+		panic(fmt.Sprintf("LineComment.go: json.Marshal(%d) got %s, %v, want %q", int(l), b, err, str))
+	}
+	var j LineComment
+	if err := json.Unmarshal(b, &j); err != nil || j != l {
+		panic(fmt.Sprintf("LineComment.go: json.Unmarshal(%s) got %d, %v, want %d", b, j, err, l))
+	}
+
+	text, _ := l.MarshalText()
+	var t LineComment
+	if err := t.UnmarshalText(text); err != nil || t != l || string(text) != str {
+		panic(fmt.Sprintf("LineComment.go: text round-tripped %q as %d, %v, want %d", text, t, err, l))
+	}
+
+	v, _ := l.Value()
+	var s LineComment
+	if err := s.Scan(v); err != nil || s != l || v != str {
+		panic(fmt.Sprintf("LineComment.go: sql round-tripped %v as %d, %v, want %d", v, s, err, l))
+	}
+}
@@ -0,0 +1,344 @@
+// Code generated by "go-enum -type LineComment -trimprefix LineComment -linecomment"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[LineCommentNew-0]
+	_ = x[LineCommentActive-1]
+	_ = x[LineCommentIdle-2]
+	_ = x[LineCommentClosed-3]
+	_ = x[LineCommentHijacked-4]
+}
+
+const _LineComment_name = "new_connectionactive_connectionIdleClosedhijacked by the handler"
+
+var _LineComment_index = [...]uint8{0, 14, 31, 35, 41, 64}
+
+func _() {
+	var _nil_LineComment_value = func() (val LineComment) { return }()
+
+	// An "cannot convert LineComment literal (type LineComment) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_LineComment_value
+}
+
+func (i LineComment) String() string {
+	if i < 0 || i >= LineComment(len(_LineComment_index)-1) {
+		return "LineComment(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _LineComment_name[_LineComment_index[i]:_LineComment_index[i+1]]
+}
+
+// New returns a pointer to a new addr filled with the LineComment value passed in.
+func (i LineComment) New() *LineComment {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *LineComment
+// to a constant, whose address cannot be taken.
+func (i LineComment) Ptr() *LineComment {
+	return &i
+}
+
+var _LineComment_values = []LineComment{0, 1, 2, 3, 4}
+
+var _LineComment_name_to_values = map[string]LineComment{
+	_LineComment_name[0:14]:  0,
+	_LineComment_name[14:31]: 1,
+	_LineComment_name[31:35]: 2,
+	_LineComment_name[35:41]: 3,
+	_LineComment_name[41:64]: 4,
+}
+
+// ParseLineCommentString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseLineCommentString(s string) (LineComment, error) {
+	if val, ok := _LineComment_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to LineComment values", s)
+}
+
+// MustParseLineCommentString is like ParseLineCommentString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseLineCommentString(s string) LineComment {
+	val, err := ParseLineCommentString(s)
+	if err != nil {
+		panic("MustParseLineCommentString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// LineCommentValues returns all values of the enum
+func LineCommentValues() []LineComment {
+	return _LineComment_values
+}
+
+// Next returns the registered value of LineComment following i, in the order of LineCommentValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i LineComment) Next() (LineComment, bool) {
+	for _, v := range _LineComment_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of LineComment preceding i, in the order of LineCommentValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i LineComment) Prev() (LineComment, bool) {
+	for j := len(_LineComment_values) - 1; j >= 0; j-- {
+		if v := _LineComment_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i LineComment) Registered() bool {
+	for _, v := range _LineComment_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_LineComment_value = func() (val LineComment) { return }()
+
+	// An "cannot convert LineComment literal (type LineComment) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_LineComment_value
+
+	// An "cannot convert LineComment literal (type LineComment) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_LineComment_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for LineComment
+func (i LineComment) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for LineComment
+func (i *LineComment) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseLineCommentString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_LineComment_value = func() (val LineComment) { return }()
+
+	// An "cannot convert LineComment literal (type LineComment) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_LineComment_value
+
+	// An "cannot convert LineComment literal (type LineComment) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_LineComment_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for LineComment
+func (i LineComment) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for LineComment
+func (i *LineComment) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("LineComment should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseLineCommentString(s)
+	return err
+}
+
+func _() {
+	var _nil_LineComment_value = func() (val LineComment) { return }()
+
+	// An "cannot convert LineComment literal (type LineComment) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_LineComment_value
+
+	// An "cannot convert LineComment literal (type LineComment) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_LineComment_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for LineComment
+func (i LineComment) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for LineComment
+func (i *LineComment) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseLineCommentString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_LineComment_value = func() (val LineComment) { return }()
+//
+//	// An "cannot convert LineComment literal (type LineComment) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_LineComment_value
+//
+//	// An "cannot convert LineComment literal (type LineComment) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_LineComment_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for LineComment
+func (i LineComment) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for LineComment
+func (i *LineComment) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseLineCommentString(s)
+	return err
+}
+
+func _() {
+	var _nil_LineComment_value = func() (val LineComment) { return }()
+
+	// An "cannot convert LineComment literal (type LineComment) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_LineComment_value
+
+	// An "cannot convert LineComment literal (type LineComment) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_LineComment_value
+}
+
+func (i LineComment) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for LineComment, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of LineComment.
+func (i *LineComment) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val LineComment
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseLineCommentString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseLineCommentString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = LineComment(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = LineComment(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = LineComment(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to LineComment values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_LineComment_value = func() (val LineComment) { return }()
+
+	// An "cannot convert LineComment literal (type LineComment) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_LineComment_value
+}
+
+// Set implements the flag.Value interface for LineComment, so that a LineComment can be a command-line flag, by flag.Var.
+func (i *LineComment) Set(s string) error {
+	v, err := ParseLineCommentString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// LineCommentSliceContains reports whether sunEnums is within enums.
+func LineCommentSliceContains(enums []LineComment, sunEnums ...LineComment) bool {
+	var seenEnums = map[LineComment]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LineCommentSliceContainsAny reports whether any sunEnum is within enums.
+func LineCommentSliceContainsAny(enums []LineComment, sunEnums ...LineComment) bool {
+	var seenEnums = map[LineComment]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
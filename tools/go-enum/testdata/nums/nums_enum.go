@@ -69,7 +69,7 @@ func NumsValues() []Nums {
 	return _Nums_values
 }
 
-// IsANums returns "true" if the value is listed in the enum definition. "false" otherwise
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
 func (i Nums) Registered() bool {
 	for _, v := range _Nums_values {
 		if i == v {
@@ -0,0 +1,55 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Random values drawn from an enumeration with gaps.
+
+package main
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+//go:generate go-enum -type "RandomColor" -random
+type RandomColor int
+
+const (
+	RandomColorRed RandomColor = iota + 1
+	RandomColorGreen
+	RandomColorBlue
+	RandomColorBlack   = 10
+	RandomColorWhite   = 20
+	RandomColorCrimson = RandomColorRed // Duplicate; drawn as often as the others, not twice as often.
+)
+
+func main() {
+	const n = 10000
+	seen := map[RandomColor]int{}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		c := RandomColorRandom(r)
+		if !c.Registered() {
+			panic(fmt.Sprintf("RandomColor.go: got unregistered %d", int(c)))
+		}
+		seen[c]++
+	}
+	values := RandomColorValues()
+	if len(seen) != len(values) {
+		panic(fmt.Sprintf("RandomColor.go: got %v, expect all of %v", seen, values))
+	}
+	for _, v := range values {
+		// uniform, with a loose bound of 20% off the mean
+		if got, mean := seen[v], n/len(values); got < mean*4/5 || got > mean*6/5 {
+			panic(fmt.Sprintf("RandomColor.go: got %s %d times in %d, expect about %d", v, got, n, mean))
+		}
+	}
+
+	// reproducible by seed
+	r1, r2 := rand.New(rand.NewSource(42)), rand.New(rand.NewSource(42))
+	for i := 0; i < 100; i++ {
+		if c1, c2 := RandomColorRandom(r1), RandomColorRandom(r2); c1 != c2 {
+			panic(fmt.Sprintf("RandomColor.go: got %s and %s from the same seed", c1, c2))
+		}
+	}
+}
@@ -0,0 +1,348 @@
+// Code generated by "go-enum -type RandomColor -trimprefix RandomColor -random"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[RandomColorRed-1]
+	_ = x[RandomColorGreen-2]
+	_ = x[RandomColorBlue-3]
+}
+
+const _RandomColor_name = "RedGreenBlue"
+
+var _RandomColor_index = [...]uint8{0, 3, 8, 12}
+
+func _() {
+	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_RandomColor_value
+}
+
+func (i RandomColor) String() string {
+	i -= 1
+	if i < 0 || i >= RandomColor(len(_RandomColor_index)-1) {
+		return "RandomColor(" + strconv.FormatInt(int64(i+1), 10) + ")"
+	}
+	return _RandomColor_name[_RandomColor_index[i]:_RandomColor_index[i+1]]
+}
+
+// New returns a pointer to a new addr filled with the RandomColor value passed in.
+func (i RandomColor) New() *RandomColor {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *RandomColor
+// to a constant, whose address cannot be taken.
+func (i RandomColor) Ptr() *RandomColor {
+	return &i
+}
+
+var _RandomColor_values = []RandomColor{1, 2, 3}
+
+var _RandomColor_name_to_values = map[string]RandomColor{
+	_RandomColor_name[0:3]:  1,
+	_RandomColor_name[3:8]:  2,
+	_RandomColor_name[8:12]: 3,
+}
+
+// ParseRandomColorString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseRandomColorString(s string) (RandomColor, error) {
+	if val, ok := _RandomColor_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to RandomColor values", s)
+}
+
+// MustParseRandomColorString is like ParseRandomColorString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseRandomColorString(s string) RandomColor {
+	val, err := ParseRandomColorString(s)
+	if err != nil {
+		panic("MustParseRandomColorString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// RandomColorValues returns all values of the enum
+func RandomColorValues() []RandomColor {
+	return _RandomColor_values
+}
+
+// Next returns the registered value of RandomColor following i, in the order of RandomColorValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i RandomColor) Next() (RandomColor, bool) {
+	for _, v := range _RandomColor_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of RandomColor preceding i, in the order of RandomColorValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i RandomColor) Prev() (RandomColor, bool) {
+	for j := len(_RandomColor_values) - 1; j >= 0; j-- {
+		if v := _RandomColor_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i RandomColor) Registered() bool {
+	for _, v := range _RandomColor_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_RandomColor_value
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_RandomColor_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for RandomColor
+func (i RandomColor) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for RandomColor
+func (i *RandomColor) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseRandomColorString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_RandomColor_value
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_RandomColor_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for RandomColor
+func (i RandomColor) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for RandomColor
+func (i *RandomColor) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("RandomColor should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseRandomColorString(s)
+	return err
+}
+
+func _() {
+	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_RandomColor_value
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_RandomColor_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for RandomColor
+func (i RandomColor) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for RandomColor
+func (i *RandomColor) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseRandomColorString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+//
+//	// An "cannot convert RandomColor literal (type RandomColor) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_RandomColor_value
+//
+//	// An "cannot convert RandomColor literal (type RandomColor) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_RandomColor_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for RandomColor
+func (i RandomColor) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for RandomColor
+func (i *RandomColor) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseRandomColorString(s)
+	return err
+}
+
+func _() {
+	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_RandomColor_value
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_RandomColor_value
+}
+
+func (i RandomColor) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for RandomColor, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of RandomColor.
+func (i *RandomColor) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val RandomColor
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseRandomColorString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseRandomColorString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = RandomColor(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = RandomColor(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = RandomColor(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to RandomColor values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_RandomColor_value = func() (val RandomColor) { return }()
+
+	// An "cannot convert RandomColor literal (type RandomColor) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_RandomColor_value
+}
+
+// Set implements the flag.Value interface for RandomColor, so that a RandomColor can be a command-line flag, by flag.Var.
+func (i *RandomColor) Set(s string) error {
+	v, err := ParseRandomColorString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// RandomColorSliceContains reports whether sunEnums is within enums.
+func RandomColorSliceContains(enums []RandomColor, sunEnums ...RandomColor) bool {
+	var seenEnums = map[RandomColor]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// RandomColorSliceContainsAny reports whether any sunEnum is within enums.
+func RandomColorSliceContainsAny(enums []RandomColor, sunEnums ...RandomColor) bool {
+	var seenEnums = map[RandomColor]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RandomColorRandom returns a value chosen uniformly at random by r from the registered values of RandomColor,
+// for property-based and fuzz tests. A seeded r makes tests reproducible.
+func RandomColorRandom(r *rand.Rand) RandomColor {
+	return _RandomColor_values[r.Intn(len(_RandomColor_values))]
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Enumeration with explicit values too sparse for runs, looked up by a map.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+//go:generate go-enum -type "Sparse" -trimprefix "Sparse"
+type Sparse int
+
+const (
+	SparseA Sparse = -100
+	SparseB Sparse = 2
+	SparseC Sparse = 20
+	SparseD Sparse = 200
+	SparseE Sparse = 2000
+	SparseF Sparse = 20000
+	SparseG Sparse = 200000
+	SparseH Sparse = 2000000
+	SparseI Sparse = 20000000
+	SparseJ Sparse = 200000000
+	SparseK Sparse = 2000000000
+	SparseZ        = SparseA // Duplicate; named A.
+)
+
+func main() {
+	values := []Sparse{SparseA, SparseB, SparseC, SparseD, SparseE, SparseF, SparseG, SparseH, SparseI, SparseJ, SparseK, SparseZ}
+	names := []string{"A", "B", "C", "D", "E", "F", "G", "H", "I", "J", "K", "A"}
+	for i, s := range values {
+		name := names[i]
+		if s.String() != name {
+			panic(fmt.Sprintf("Sparse.go: got %s, expect %s", s.String(), name))
+		}
+		if !s.Registered() {
+			panic(fmt.Sprintf("Sparse.go: %d not registered", int(s)))
+		}
+		if got, err := ParseSparseString(name); err != nil || got != s {
+			panic(fmt.Sprintf("Sparse.go: parsed %s as %d, %v, expect %d", name, int(got), err, int(s)))
+		}
+		b, err := json.Marshal(s)
+		if err != nil || string(b) != fmt.Sprintf("%q", name) {
+			panic(fmt.Sprintf("Sparse.go: json.Marshal got %s, %v, expect %q", b, err, name))
+		}
+	}
+	for _, s := range []Sparse{0, 1, 3, -99, 201} {
+		if s.Registered() || s.String() != fmt.Sprintf("Sparse(%d)", int(s)) {
+			panic(fmt.Sprintf("Sparse.go: got %s registered %t for %d", s.String(), s.Registered(), int(s)))
+		}
+	}
+	if got := len(SparseValues()); got != 11 {
+		panic(fmt.Sprintf("Sparse.go: got %d values, expect 11", got))
+	}
+
+	// walk the registered values forward by Next, and back by Prev
+	ordered := values[:len(values)-1]
+	for i, s := range ordered {
+		next, ok := s.Next()
+		if i == len(ordered)-1 {
+			if ok {
+				panic(fmt.Sprintf("Sparse.go: %s.Next() got %s after the last value", s, next))
+			}
+		} else if !ok || next != ordered[i+1] {
+			panic(fmt.Sprintf("Sparse.go: %s.Next() got %s, %t, expect %s", s, next, ok, ordered[i+1]))
+		}
+		prev, ok := s.Prev()
+		if i == 0 {
+			if ok {
+				panic(fmt.Sprintf("Sparse.go: %s.Prev() got %s before the first value", s, prev))
+			}
+		} else if !ok || prev != ordered[i-1] {
+			panic(fmt.Sprintf("Sparse.go: %s.Prev() got %s, %t, expect %s", s, prev, ok, ordered[i-1]))
+		}
+	}
+	// unregistered values move to the nearest registered ones
+	if next, ok := Sparse(3).Next(); !ok || next != SparseC {
+		panic(fmt.Sprintf("Sparse.go: Sparse(3).Next() got %s, %t, expect C", next, ok))
+	}
+	if prev, ok := Sparse(3).Prev(); !ok || prev != SparseB {
+		panic(fmt.Sprintf("Sparse.go: Sparse(3).Prev() got %s, %t, expect B", prev, ok))
+	}
+	if prev, ok := Sparse(-101).Prev(); ok {
+		panic(fmt.Sprintf("Sparse.go: Sparse(-101).Prev() got %s", prev))
+	}
+}
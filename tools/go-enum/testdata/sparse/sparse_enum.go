@@ -0,0 +1,364 @@
+// Code generated by "go-enum -type Sparse -trimprefix Sparse"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SparseA - -100]
+	_ = x[SparseB-2]
+	_ = x[SparseC-20]
+	_ = x[SparseD-200]
+	_ = x[SparseE-2000]
+	_ = x[SparseF-20000]
+	_ = x[SparseG-200000]
+	_ = x[SparseH-2000000]
+	_ = x[SparseI-20000000]
+	_ = x[SparseJ-200000000]
+	_ = x[SparseK-2000000000]
+}
+
+const _Sparse_name = "ABCDEFGHIJK"
+
+var _Sparse_map = map[Sparse]string{
+	-100:       _Sparse_name[0:1],
+	2:          _Sparse_name[1:2],
+	20:         _Sparse_name[2:3],
+	200:        _Sparse_name[3:4],
+	2000:       _Sparse_name[4:5],
+	20000:      _Sparse_name[5:6],
+	200000:     _Sparse_name[6:7],
+	2000000:    _Sparse_name[7:8],
+	20000000:   _Sparse_name[8:9],
+	200000000:  _Sparse_name[9:10],
+	2000000000: _Sparse_name[10:11],
+}
+
+func _() {
+	var _nil_Sparse_value = func() (val Sparse) { return }()
+
+	// An "cannot convert Sparse literal (type Sparse) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_Sparse_value
+}
+
+func (i Sparse) String() string {
+	if str, ok := _Sparse_map[i]; ok {
+		return str
+	}
+	return "Sparse(" + strconv.FormatInt(int64(i), 10) + ")"
+}
+
+// New returns a pointer to a new addr filled with the Sparse value passed in.
+func (i Sparse) New() *Sparse {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *Sparse
+// to a constant, whose address cannot be taken.
+func (i Sparse) Ptr() *Sparse {
+	return &i
+}
+
+var _Sparse_values = []Sparse{-100, 2, 20, 200, 2000, 20000, 200000, 2000000, 20000000, 200000000, 2000000000}
+
+var _Sparse_name_to_values = map[string]Sparse{
+	_Sparse_name[0:1]:   -100,
+	_Sparse_name[1:2]:   2,
+	_Sparse_name[2:3]:   20,
+	_Sparse_name[3:4]:   200,
+	_Sparse_name[4:5]:   2000,
+	_Sparse_name[5:6]:   20000,
+	_Sparse_name[6:7]:   200000,
+	_Sparse_name[7:8]:   2000000,
+	_Sparse_name[8:9]:   20000000,
+	_Sparse_name[9:10]:  200000000,
+	_Sparse_name[10:11]: 2000000000,
+}
+
+// ParseSparseString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseSparseString(s string) (Sparse, error) {
+	if val, ok := _Sparse_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Sparse values", s)
+}
+
+// MustParseSparseString is like ParseSparseString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseSparseString(s string) Sparse {
+	val, err := ParseSparseString(s)
+	if err != nil {
+		panic("MustParseSparseString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// SparseValues returns all values of the enum
+func SparseValues() []Sparse {
+	return _Sparse_values
+}
+
+// Next returns the registered value of Sparse following i, in the order of SparseValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i Sparse) Next() (Sparse, bool) {
+	for _, v := range _Sparse_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of Sparse preceding i, in the order of SparseValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i Sparse) Prev() (Sparse, bool) {
+	for j := len(_Sparse_values) - 1; j >= 0; j-- {
+		if v := _Sparse_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Sparse) Registered() bool {
+	_, ok := _Sparse_map[i]
+	return ok
+}
+
+func _() {
+	var _nil_Sparse_value = func() (val Sparse) { return }()
+
+	// An "cannot convert Sparse literal (type Sparse) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_Sparse_value
+
+	// An "cannot convert Sparse literal (type Sparse) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_Sparse_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Sparse
+func (i Sparse) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Sparse
+func (i *Sparse) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseSparseString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_Sparse_value = func() (val Sparse) { return }()
+
+	// An "cannot convert Sparse literal (type Sparse) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_Sparse_value
+
+	// An "cannot convert Sparse literal (type Sparse) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_Sparse_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for Sparse
+func (i Sparse) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Sparse
+func (i *Sparse) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Sparse should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseSparseString(s)
+	return err
+}
+
+func _() {
+	var _nil_Sparse_value = func() (val Sparse) { return }()
+
+	// An "cannot convert Sparse literal (type Sparse) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_Sparse_value
+
+	// An "cannot convert Sparse literal (type Sparse) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_Sparse_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Sparse
+func (i Sparse) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Sparse
+func (i *Sparse) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseSparseString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_Sparse_value = func() (val Sparse) { return }()
+//
+//	// An "cannot convert Sparse literal (type Sparse) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_Sparse_value
+//
+//	// An "cannot convert Sparse literal (type Sparse) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_Sparse_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for Sparse
+func (i Sparse) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for Sparse
+func (i *Sparse) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseSparseString(s)
+	return err
+}
+
+func _() {
+	var _nil_Sparse_value = func() (val Sparse) { return }()
+
+	// An "cannot convert Sparse literal (type Sparse) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_Sparse_value
+
+	// An "cannot convert Sparse literal (type Sparse) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_Sparse_value
+}
+
+func (i Sparse) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for Sparse, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of Sparse.
+func (i *Sparse) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Sparse
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseSparseString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseSparseString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = Sparse(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = Sparse(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = Sparse(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to Sparse values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_Sparse_value = func() (val Sparse) { return }()
+
+	// An "cannot convert Sparse literal (type Sparse) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_Sparse_value
+}
+
+// Set implements the flag.Value interface for Sparse, so that a Sparse can be a command-line flag, by flag.Var.
+func (i *Sparse) Set(s string) error {
+	v, err := ParseSparseString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// SparseSliceContains reports whether sunEnums is within enums.
+func SparseSliceContains(enums []Sparse, sunEnums ...Sparse) bool {
+	var seenEnums = map[Sparse]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SparseSliceContainsAny reports whether any sunEnum is within enums.
+func SparseSliceContainsAny(enums []Sparse, sunEnums ...Sparse) bool {
+	var seenEnums = map[Sparse]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
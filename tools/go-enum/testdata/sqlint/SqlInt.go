@@ -0,0 +1,72 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Values written to SQL as integers, and scanned from integers or names.
+
+package main
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+)
+
+//go:generate go-enum -type SqlInt -trimprefix SqlInt -sqlint
+type SqlInt int8
+
+const (
+	SqlIntNew    SqlInt = -1
+	SqlIntActive SqlInt = 1
+	SqlIntIdle   SqlInt = 2
+	SqlIntClosed SqlInt = 10
+)
+
+type myInt int
+
+func main() {
+	for _, s := range SqlIntValues() {
+		v, err := s.Value()
+		if err != nil || v != driver.Value(int64(s)) {
+			panic(fmt.Sprintf("SqlInt.go: %s.Value() got %#v, %v, want %d", s, v, err, int64(s)))
+		}
+		if err := driver.IsValue(v); !err {
+			panic(fmt.Sprintf("SqlInt.go: %s.Value() got %#v, not a driver.Value", s, v))
+		}
+		ckScan(v, s)
+		ckScan(s.String(), s)
+		ckScan([]byte(s.String()), s)
+	}
+	ckScan(int32(2), SqlIntIdle)
+	ckScan(uint64(10), SqlIntClosed)
+	ckScan(myInt(-1), SqlIntNew)
+	ckScan(float64(1), SqlIntActive)
+
+	for _, v := range []any{int64(0), int64(3), int64(257), int64(math.MinInt64), uint64(math.MaxUint64), 1.5, math.NaN(),
+		"1", "Unknown", []byte("SqlIntNew"), true} {
+		ckScanError(v)
+	}
+
+	// scanning nil leaves the value as is
+	s := SqlIntIdle
+	if err := s.Scan(nil); err != nil || s != SqlIntIdle {
+		panic(fmt.Sprintf("SqlInt.go: Scan(nil) got %s, %v", s, err))
+	}
+}
+
+func ckScan(v any, want SqlInt) {
+	var got SqlInt
+	if err := got.Scan(v); err != nil || got != want {
+		panic(fmt.Sprintf("SqlInt.go: Scan(%#v) got %s, %v, want %s", v, got, err, want))
+	}
+}
+
+func ckScanError(v any) {
+	got := SqlIntIdle
+	if err := got.Scan(v); err == nil {
+		panic(fmt.Sprintf("SqlInt.go: Scan(%#v) got %s, want an error", v, got))
+	}
+	if got != SqlIntIdle {
+		panic(fmt.Sprintf("SqlInt.go: Scan(%#v) changed the value to %s on error", v, got))
+	}
+}
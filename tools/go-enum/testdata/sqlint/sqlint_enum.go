@@ -0,0 +1,347 @@
+// Code generated by "go-enum -type SqlInt -trimprefix SqlInt -sqlint"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[SqlIntNew - -1]
+	_ = x[SqlIntActive-1]
+	_ = x[SqlIntIdle-2]
+	_ = x[SqlIntClosed-10]
+}
+
+const (
+	_SqlInt_name_0 = "New"
+	_SqlInt_name_1 = "ActiveIdle"
+	_SqlInt_name_2 = "Closed"
+)
+
+var (
+	_SqlInt_index_1 = [...]uint8{0, 6, 10}
+)
+
+func (i SqlInt) String() string {
+	switch {
+	case i == -1:
+		return _SqlInt_name_0
+	case 1 <= i && i <= 2:
+		i -= 1
+		return _SqlInt_name_1[_SqlInt_index_1[i]:_SqlInt_index_1[i+1]]
+	case i == 10:
+		return _SqlInt_name_2
+	default:
+		return "SqlInt(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+}
+
+// New returns a pointer to a new addr filled with the SqlInt value passed in.
+func (i SqlInt) New() *SqlInt {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *SqlInt
+// to a constant, whose address cannot be taken.
+func (i SqlInt) Ptr() *SqlInt {
+	return &i
+}
+
+var _SqlInt_values = []SqlInt{-1, 1, 2, 10}
+
+var _SqlInt_name_to_values = map[string]SqlInt{
+	_SqlInt_name_0[0:3]:  -1,
+	_SqlInt_name_1[0:6]:  1,
+	_SqlInt_name_1[6:10]: 2,
+	_SqlInt_name_2[0:6]:  10,
+}
+
+// ParseSqlIntString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseSqlIntString(s string) (SqlInt, error) {
+	if val, ok := _SqlInt_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to SqlInt values", s)
+}
+
+// MustParseSqlIntString is like ParseSqlIntString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseSqlIntString(s string) SqlInt {
+	val, err := ParseSqlIntString(s)
+	if err != nil {
+		panic("MustParseSqlIntString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// SqlIntValues returns all values of the enum
+func SqlIntValues() []SqlInt {
+	return _SqlInt_values
+}
+
+// Next returns the registered value of SqlInt following i, in the order of SqlIntValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i SqlInt) Next() (SqlInt, bool) {
+	for _, v := range _SqlInt_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of SqlInt preceding i, in the order of SqlIntValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i SqlInt) Prev() (SqlInt, bool) {
+	for j := len(_SqlInt_values) - 1; j >= 0; j-- {
+		if v := _SqlInt_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i SqlInt) Registered() bool {
+	for _, v := range _SqlInt_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_SqlInt_value = func() (val SqlInt) { return }()
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_SqlInt_value
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_SqlInt_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for SqlInt
+func (i SqlInt) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for SqlInt
+func (i *SqlInt) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseSqlIntString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_SqlInt_value = func() (val SqlInt) { return }()
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_SqlInt_value
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_SqlInt_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for SqlInt
+func (i SqlInt) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for SqlInt
+func (i *SqlInt) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("SqlInt should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseSqlIntString(s)
+	return err
+}
+
+func _() {
+	var _nil_SqlInt_value = func() (val SqlInt) { return }()
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_SqlInt_value
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_SqlInt_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for SqlInt
+func (i SqlInt) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for SqlInt
+func (i *SqlInt) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseSqlIntString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_SqlInt_value = func() (val SqlInt) { return }()
+//
+//	// An "cannot convert SqlInt literal (type SqlInt) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_SqlInt_value
+//
+//	// An "cannot convert SqlInt literal (type SqlInt) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_SqlInt_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for SqlInt
+func (i SqlInt) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for SqlInt
+func (i *SqlInt) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseSqlIntString(s)
+	return err
+}
+
+func _() {
+	var _nil_SqlInt_value = func() (val SqlInt) { return }()
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_SqlInt_value
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_SqlInt_value
+}
+
+func (i SqlInt) Value() (driver.Value, error) {
+	return int64(i), nil
+}
+
+// Scan implements the database/sql.Scanner interface for SqlInt, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of SqlInt.
+func (i *SqlInt) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val SqlInt
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseSqlIntString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseSqlIntString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = SqlInt(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = SqlInt(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = SqlInt(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to SqlInt values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_SqlInt_value = func() (val SqlInt) { return }()
+
+	// An "cannot convert SqlInt literal (type SqlInt) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_SqlInt_value
+}
+
+// Set implements the flag.Value interface for SqlInt, so that a SqlInt can be a command-line flag, by flag.Var.
+func (i *SqlInt) Set(s string) error {
+	v, err := ParseSqlIntString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// SqlIntSliceContains reports whether sunEnums is within enums.
+func SqlIntSliceContains(enums []SqlInt, sunEnums ...SqlInt) bool {
+	var seenEnums = map[SqlInt]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// SqlIntSliceContainsAny reports whether any sunEnum is within enums.
+func SqlIntSliceContainsAny(enums []SqlInt, sunEnums ...SqlInt) bool {
+	var seenEnums = map[SqlInt]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
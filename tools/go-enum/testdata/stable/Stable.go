@@ -0,0 +1,50 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Values persisted by their stable keys.
+
+package main
+
+import "fmt"
+
+//go:generate go-enum -type Stable -trimprefix Stable -stable
+type Stable int
+
+// The constants are declared out of the order of their values, as after reordering.
+const (
+	StableClosed Stable = iota + 2
+	StableIdle
+	StableNew    Stable = 0
+	StableActive Stable = 1
+	StableAlias         = StableIdle
+)
+
+func main() {
+	ck(StableNew, "New")
+	ck(StableActive, "Active")
+	ck(StableClosed, "Closed")
+	ck(StableIdle, "Idle")
+	ck(StableAlias, "Idle")
+
+	if key := Stable(127).StableKey(); key != "" {
+		panic(fmt.Sprintf("Stable.go: Stable(127).StableKey() got %q, want none", key))
+	}
+	for _, key := range []string{"", "Stable(127)", "new", "StableNew"} {
+		if _, err := ParseStableStableKey(key); err == nil {
+			panic(fmt.Sprintf("Stable.go: ParseStableStableKey(%q) got no error", key))
+		}
+	}
+}
+
+func ck(s Stable, key string) {
+	if got := s.StableKey(); got != key {
+		panic(fmt.Sprintf("Stable.go: %d.StableKey() got %q, want %q", s, got, key))
+	}
+	if got, err := ParseStableStableKey(key); err != nil || got != s {
+		panic(fmt.Sprintf("Stable.go: ParseStableStableKey(%q) got %d, %v, want %d", key, got, err, s))
+	}
+	if got := s.String(); got != key {
+		panic(fmt.Sprintf("Stable.go: %d.String() got %q, want the stable key %q", s, got, key))
+	}
+}
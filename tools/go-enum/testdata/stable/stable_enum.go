@@ -0,0 +1,373 @@
+// Code generated by "go-enum -type Stable -trimprefix Stable -stable"; DO NOT EDIT.
+
+// Install go-enum by `go get install github.com/searKing/golang/tools/go-enum`
+package main
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+func _() {
+	// An "invalid array index" compiler error signifies that the constant values have changed.
+	// Re-run the stringer command to generate them again.
+	var x [1]struct{}
+	_ = x[StableClosed-2]
+	_ = x[StableIdle-3]
+	_ = x[StableNew-0]
+	_ = x[StableActive-1]
+}
+
+const _Stable_name = "NewActiveClosedIdle"
+
+var _Stable_index = [...]uint8{0, 3, 9, 15, 19}
+
+func _() {
+	var _nil_Stable_value = func() (val Stable) { return }()
+
+	// An "cannot convert Stable literal (type Stable) to type fmt.Stringer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ fmt.Stringer = _nil_Stable_value
+}
+
+func (i Stable) String() string {
+	if i < 0 || i >= Stable(len(_Stable_index)-1) {
+		return "Stable(" + strconv.FormatInt(int64(i), 10) + ")"
+	}
+	return _Stable_name[_Stable_index[i]:_Stable_index[i+1]]
+}
+
+// New returns a pointer to a new addr filled with the Stable value passed in.
+func (i Stable) New() *Stable {
+	clone := i
+	return &clone
+}
+
+// Ptr returns a pointer to a copy of i, as New does, such as to set an optional field of *Stable
+// to a constant, whose address cannot be taken.
+func (i Stable) Ptr() *Stable {
+	return &i
+}
+
+var _Stable_values = []Stable{0, 1, 2, 3}
+
+var _Stable_name_to_values = map[string]Stable{
+	_Stable_name[0:3]:   0,
+	_Stable_name[3:9]:   1,
+	_Stable_name[9:15]:  2,
+	_Stable_name[15:19]: 3,
+}
+
+// ParseStableString retrieves an enum value from the enum constants string name.
+// Throws an error if the param is not part of the enum.
+func ParseStableString(s string) (Stable, error) {
+	if val, ok := _Stable_name_to_values[s]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s does not belong to Stable values", s)
+}
+
+// MustParseStableString is like ParseStableString but panics if the param is not part of the enum.
+// It simplifies safe initialization of global variables holding enum values.
+func MustParseStableString(s string) Stable {
+	val, err := ParseStableString(s)
+	if err != nil {
+		panic("MustParseStableString(" + strconv.Quote(s) + "): " + err.Error())
+	}
+	return val
+}
+
+// StableValues returns all values of the enum
+func StableValues() []Stable {
+	return _Stable_values
+}
+
+// Next returns the registered value of Stable following i, in the order of StableValues,
+// and false if i is the last one, or no registered value is greater than i.
+func (i Stable) Next() (Stable, bool) {
+	for _, v := range _Stable_values {
+		if v > i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Prev returns the registered value of Stable preceding i, in the order of StableValues,
+// and false if i is the first one, or no registered value is less than i.
+func (i Stable) Prev() (Stable, bool) {
+	for j := len(_Stable_values) - 1; j >= 0; j-- {
+		if v := _Stable_values[j]; v < i {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
+func (i Stable) Registered() bool {
+	for _, v := range _Stable_values {
+		if i == v {
+			return true
+		}
+	}
+	return false
+}
+
+func _() {
+	var _nil_Stable_value = func() (val Stable) { return }()
+
+	// An "cannot convert Stable literal (type Stable) to type encoding.BinaryMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryMarshaler = &_nil_Stable_value
+
+	// An "cannot convert Stable literal (type Stable) to type encoding.BinaryUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.BinaryUnmarshaler = &_nil_Stable_value
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface for Stable
+func (i Stable) MarshalBinary() (data []byte, err error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface for Stable
+func (i *Stable) UnmarshalBinary(data []byte) error {
+	var err error
+	*i, err = ParseStableString(string(data))
+	return err
+}
+
+func _() {
+	var _nil_Stable_value = func() (val Stable) { return }()
+
+	// An "cannot convert Stable literal (type Stable) to type json.Marshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Marshaler = _nil_Stable_value
+
+	// An "cannot convert Stable literal (type Stable) to type encoding.Unmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ json.Unmarshaler = &_nil_Stable_value
+}
+
+// MarshalJSON implements the json.Marshaler interface for Stable
+func (i Stable) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface for Stable
+func (i *Stable) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("Stable should be a string, got %s", data)
+	}
+
+	var err error
+	*i, err = ParseStableString(s)
+	return err
+}
+
+func _() {
+	var _nil_Stable_value = func() (val Stable) { return }()
+
+	// An "cannot convert Stable literal (type Stable) to type encoding.TextMarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextMarshaler = _nil_Stable_value
+
+	// An "cannot convert Stable literal (type Stable) to type encoding.TextUnmarshaler" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ encoding.TextUnmarshaler = &_nil_Stable_value
+}
+
+// MarshalText implements the encoding.TextMarshaler interface for Stable
+func (i Stable) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface for Stable
+func (i *Stable) UnmarshalText(text []byte) error {
+	var err error
+	*i, err = ParseStableString(string(text))
+	return err
+}
+
+//func _() {
+//	var _nil_Stable_value = func() (val Stable) { return }()
+//
+//	// An "cannot convert Stable literal (type Stable) to type yaml.Marshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Marshaler = _nil_Stable_value
+//
+//	// An "cannot convert Stable literal (type Stable) to type yaml.Unmarshaler" compiler error signifies that the base type have changed.
+//	// Re-run the go-enum command to generate them again.
+//	var _ yaml.Unmarshaler = &_nil_Stable_value
+//}
+
+// MarshalYAML implements a YAML Marshaler for Stable
+func (i Stable) MarshalYAML() (interface{}, error) {
+	return i.String(), nil
+}
+
+// UnmarshalYAML implements a YAML Unmarshaler for Stable
+func (i *Stable) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+
+	var err error
+	*i, err = ParseStableString(s)
+	return err
+}
+
+func _() {
+	var _nil_Stable_value = func() (val Stable) { return }()
+
+	// An "cannot convert Stable literal (type Stable) to type driver.Valuer" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ driver.Valuer = _nil_Stable_value
+
+	// An "cannot convert Stable literal (type Stable) to type sql.Scanner" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ sql.Scanner = &_nil_Stable_value
+}
+
+func (i Stable) Value() (driver.Value, error) {
+	return i.String(), nil
+}
+
+// Scan implements the database/sql.Scanner interface for Stable, scanning a name as a string or a byte slice,
+// or a number, such as an int64 column, which must be a registered value of Stable.
+func (i *Stable) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+
+	var val Stable
+	switch v := value.(type) {
+	case string:
+		var err error
+		if val, err = ParseStableString(v); err != nil {
+			return err
+		}
+	case []byte:
+		var err error
+		if val, err = ParseStableString(string(v)); err != nil {
+			return err
+		}
+	default:
+		var exact bool
+		switch rv := reflect.ValueOf(value); {
+		case rv.CanInt():
+			val = Stable(rv.Int())
+			exact = int64(val) == rv.Int() && (val < 0) == (rv.Int() < 0)
+		case rv.CanUint():
+			val = Stable(rv.Uint())
+			exact = uint64(val) == rv.Uint() && val >= 0
+		case rv.CanFloat():
+			val = Stable(rv.Float())
+			exact = float64(val) == rv.Float()
+		default:
+			return fmt.Errorf("value of type %T is not a string, byte slice or number", value)
+		}
+		if !exact || !(val.Registered()) {
+			return fmt.Errorf("%v does not belong to Stable values", value)
+		}
+	}
+
+	*i = val
+	return nil
+}
+
+func _() {
+	var _nil_Stable_value = func() (val Stable) { return }()
+
+	// An "cannot convert Stable literal (type Stable) to type flag.Value" compiler error signifies that the base type have changed.
+	// Re-run the go-enum command to generate them again.
+	var _ flag.Value = &_nil_Stable_value
+}
+
+// Set implements the flag.Value interface for Stable, so that a Stable can be a command-line flag, by flag.Var.
+func (i *Stable) Set(s string) error {
+	v, err := ParseStableString(s)
+	if err != nil {
+		return err
+	}
+	*i = v
+	return nil
+}
+
+// StableSliceContains reports whether sunEnums is within enums.
+func StableSliceContains(enums []Stable, sunEnums ...Stable) bool {
+	var seenEnums = map[Stable]bool{}
+	for _, e := range sunEnums {
+		seenEnums[e] = false
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			seenEnums[v] = true
+		}
+	}
+
+	for _, seen := range seenEnums {
+		if !seen {
+			return false
+		}
+	}
+
+	return true
+}
+
+// StableSliceContainsAny reports whether any sunEnum is within enums.
+func StableSliceContainsAny(enums []Stable, sunEnums ...Stable) bool {
+	var seenEnums = map[Stable]struct{}{}
+	for _, e := range sunEnums {
+		seenEnums[e] = struct{}{}
+	}
+
+	for _, v := range enums {
+		if _, has := seenEnums[v]; has {
+			return true
+		}
+	}
+
+	return false
+}
+
+var _Stable_stable_key_to_values = map[string]Stable{
+	"New":    0,
+	"Active": 1,
+	"Closed": 2,
+	"Idle":   3,
+}
+
+var _Stable_value_to_stable_keys = map[Stable]string{
+	0: "New",
+	1: "Active",
+	2: "Closed",
+	3: "Idle",
+}
+
+// StableKey returns the name of the constant i is, the same as String does, as the key to persist i by.
+// Unlike the value of i, it is stable across reordering the constants of Stable, and is the persistence
+// contract: renaming a constant breaks it instead. ParseStableStableKey parses it back.
+// It returns "" if i is not registered.
+func (i Stable) StableKey() string {
+	return _Stable_value_to_stable_keys[i]
+}
+
+// ParseStableStableKey retrieves an enum value from its stable key, as returned by StableKey.
+// Throws an error if the key is not the stable key of any value of the enum.
+func ParseStableStableKey(key string) (Stable, error) {
+	if val, ok := _Stable_stable_key_to_values[key]; ok {
+		return val, nil
+	}
+	return 0, fmt.Errorf("%s is not a stable key of Stable values", key)
+}
@@ -61,7 +61,7 @@ func TransformValues() []Transform {
 	return _Transform_values
 }
 
-// IsATransform returns "true" if the value is listed in the enum definition. "false" otherwise
+// Registered returns "true" if the value is listed in the enum definition. "false" otherwise
 func (i Transform) Registered() bool {
 	for _, v := range _Transform_values {
 		if i == v {
@@ -15,6 +15,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 )
@@ -61,6 +62,10 @@ func walkDir(dir, gooptions, dirname string, t *testing.T) {
 				// This file is ignored by the build tool since it's name ends with '_options.go'.
 				continue
 			}
+			if strings.HasSuffix(name, "_test.go") {
+				// This file is generated by -gentest, or tests the program beside it, and run with the options it tests.
+				continue
+			}
 			if name == "cgo.go" && !build.Default.CgoEnabled {
 				t.Logf("cgo is not enabled for %s", name)
 				continue
@@ -87,6 +92,20 @@ func buildOptions(t *testing.T) (dir string, gooptions string) {
 	return dir, gooptions
 }
 
+// testdataFlags are the flags go-option is run with for the programs in testdata, by the name of their directory.
+var testdataFlags = map[string][]string{
+	"locked":     {"-locked"},
+	"named":      {"-named"},
+	"patch":      {"-patch"},
+	"validate":   {"-validate"},
+	"fixedarity": {"-fixed-arity=3"},
+	"builder":    {"-builder"},
+	"duration":   {"-durationhelpers"},
+	"getters":    {"-getters"},
+	"gentest":    {"-gentest"},
+	"prefix":     {"-trimprefix=Prefixed", "-prefix=Set"},
+}
+
 // gooptionsCompileAndRun runs stringer for the named file and compiles and
 // runs the target binary in directory dir. That binary will panic if the String method is incorrect.
 func gooptionsCompileAndRun(t *testing.T, dir, gooptions, typeName, fileName string) {
@@ -105,7 +124,9 @@ func gooptionsCompileAndRun(t *testing.T, dir, gooptions, typeName, fileName str
 
 	optionsSource := filepath.Join(filepath.Dir(source), castTypeNameToFileName(typeName+"_options.go"))
 	// Run gooptions in temporary directory.
-	err = run(gooptions, "-type", typeName, "-output", optionsSource, source)
+	args := []string{"-type", typeName}
+	args = append(args, testdataFlags[filepath.Base(filepath.Dir(fileName))]...)
+	err = run(gooptions, append(args, "-output", optionsSource, source)...)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -114,6 +135,30 @@ func gooptionsCompileAndRun(t *testing.T, dir, gooptions, typeName, fileName str
 	if err != nil {
 		t.Fatal(err)
 	}
+	if slices.Contains(args, "-gentest") {
+		// Run the generated test of the options.
+		err = run("go", "test", optionsSource, strings.TrimSuffix(optionsSource, ".go")+"_test.go", source)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if test := strings.TrimSuffix(fileName, ".go") + "_test.go"; fileExists(test) {
+		// Run the tests and benchmarks of the program, once each.
+		testSource := strings.TrimSuffix(source, ".go") + "_test.go"
+		if err := copy(testSource, test); err != nil {
+			t.Fatalf("copying file to temporary directory: %s", err)
+		}
+		err = run("go", "test", "-bench=.", "-benchtime=1x", optionsSource, source, testSource)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// fileExists reports whether name is a regular file.
+func fileExists(name string) bool {
+	fi, err := os.Stat(name)
+	return err == nil && fi.Mode().IsRegular()
 }
 
 // castFileNameToTypeName replace "{" "}" "^" "@" with "<" ">" "/" "*"
@@ -8,16 +8,31 @@ import (
 	"bytes"
 	"fmt"
 	"go/ast"
+	"go/build/constraint"
 	"go/token"
 	"go/types"
+	"log"
+	"reflect"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	reflect_ "github.com/searKing/golang/go/reflect"
 )
 
 const (
-	TagOption          = "option"
-	TagOptionFlagShort = "short" // `option:",short"`
+	TagOption            = "option"
+	TagOptionFlagShort   = "short"   // `option:",short"`
+	TagOptionFlagAppend  = "append"  // `option:",append"`, only the option appending to a slice or map field
+	TagOptionFlagReplace = "replace" // `option:",replace"`, only the option replacing a slice or map field
+	TagDefault           = "default" // `default:"5s"`, the default value of the field, as the +optional-default directive
+
+	lockTypeName = "sync.Mutex" // the field type guarding ApplyOptions, see flag -locked
+
+	// DirectiveOptionalDefault sets the default value of a field in its comment, such as:
+	// // +optional-default:5s
+	DirectiveOptionalDefault = "+optional-default:"
 )
 
 // FormatTypeParams turns TypeParamList into its Go representation, such as:
@@ -78,6 +93,44 @@ func FormatTypeDeclaration(tparams *ast.FieldList) (string, error) {
 	return buf.String(), nil
 }
 
+// formatTypeParams returns the type parameter list of the type declared by tspec, as go/types checked it,
+// with constraints and without, such as [K cmp.Ordered, S ~[]K] and [K, S], empty if the type is not generic.
+// Constraints refer to other packages by the names the file imports them as.
+func (f *File) formatTypeParams(tspec *ast.TypeSpec) (declaration string, params string, err error) {
+	obj, ok := f.pkg.defs[tspec.Name].(*types.TypeName)
+	if !ok {
+		declaration, err = FormatTypeDeclaration(tspec.TypeParams)
+		return declaration, FormatTypeParams(tspec.TypeParams), err
+	}
+	named, ok := obj.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() == 0 {
+		return "", "", nil
+	}
+
+	qualifier := func(pkg *types.Package) string {
+		if pkg == obj.Pkg() {
+			return ""
+		}
+		for _, spec := range f.file.Imports {
+			if path, _ := strconv.Unquote(spec.Path.Value); path != pkg.Path() || spec.Name == nil {
+				continue
+			}
+			if spec.Name.Name == "." {
+				return ""
+			}
+			return spec.Name.Name
+		}
+		return pkg.Name()
+	}
+	var decls, names []string
+	for i := 0; i < named.TypeParams().Len(); i++ {
+		tparam := named.TypeParams().At(i)
+		names = append(names, tparam.Obj().Name())
+		decls = append(decls, tparam.Obj().Name()+" "+types.TypeString(tparam.Constraint(), qualifier))
+	}
+	return "[" + strings.Join(decls, ", ") + "]", "[" + strings.Join(names, ", ") + "]", nil
+}
+
 // fieldName assumes that x is the type of an anonymous field and
 // returns the corresponding field name. If x is not an acceptable
 // anonymous field, the result is nil.
@@ -91,6 +144,10 @@ func fieldNameIndent(x ast.Expr) *ast.Ident {
 		}
 	case *ast.StarExpr:
 		return fieldNameIndent(t.X)
+	case *ast.IndexExpr:
+		return fieldNameIndent(t.X)
+	case *ast.IndexListExpr:
+		return fieldNameIndent(t.X)
 	}
 	return nil
 }
@@ -126,7 +183,7 @@ func (f *File) genDecl(node ast.Node) bool {
 	for _, spec := range decl.Specs {
 		tspec := spec.(*ast.TypeSpec) // Guaranteed to succeed as this is TYPE.
 		typ = tspec.Name.Name
-		declaration, err := FormatTypeDeclaration(tspec.TypeParams)
+		declaration, params, err := f.formatTypeParams(tspec)
 		if err != nil {
 			// This is not the type we're looking for.
 			continue
@@ -134,7 +191,7 @@ func (f *File) genDecl(node ast.Node) bool {
 		v := Struct{
 			StructTypeName:               typ,
 			StructTypeGenericDeclaration: declaration,
-			StructTypeGenericTypeParams:  FormatTypeParams(tspec.TypeParams),
+			StructTypeGenericTypeParams:  params,
 			StructTypeImport:             f.typeInfo.Import,
 		}
 		if c := tspec.Comment; f.lineComment && c != nil && len(c.List) == 1 {
@@ -168,12 +225,26 @@ func (f *File) genDecl(node ast.Node) bool {
 		}
 
 		for _, field := range sExpr.Fields.List {
+			if *locked {
+				if types.ExprString(field.Type) == lockTypeName && v.LockFieldName == "" {
+					if len(field.Names) != 0 {
+						v.LockFieldName = field.Names[0].Name
+					} else if ident := fieldNameIndent(field.Type); ident != nil {
+						v.LockFieldName = ident.Name
+					}
+					continue
+				}
+				if len(field.Names) != 0 {
+					for _, name := range field.Names {
+						v.SnapshotFieldNames = append(v.SnapshotFieldNames, name.Name)
+					}
+				} else if ident := fieldNameIndent(field.Type); ident != nil {
+					v.SnapshotFieldNames = append(v.SnapshotFieldNames, ident.Name)
+				}
+			}
+
 			var fieldName string
-			var fieldType string
-			var fieldIsMap bool
-			var fieldSliceElt string
-			fieldType, fieldIsMap, fieldSliceElt = FilterTypeName(field.Type)
-			if fieldType == "" {
+			if types.ExprString(field.Type) == "" {
 				continue
 			}
 			if len(field.Names) != 0 { // pick first exported Name
@@ -198,31 +269,398 @@ func (f *File) genDecl(node ast.Node) bool {
 			if fieldName == "" {
 				continue
 			}
-			if field.Tag == nil {
-				field.Tag = &ast.BasicLit{}
+			if sf, ok := f.structField(typ, fieldName, field); ok {
+				v.Fields = append(v.Fields, sf)
 			}
+		}
+		v.Fields = append(v.Fields, f.promotedFields(typ, sExpr)...)
+		f.structs = append(f.structs, v)
+	}
+	return false
+}
 
+// structField returns the field named fieldName of type typ declared by field, with its option tag,
+// and ok false if the field is tagged `option:"-"`.
+func (f *File) structField(typ, fieldName string, field *ast.Field) (_ StructField, ok bool) {
+	if field.Tag == nil {
+		field.Tag = &ast.BasicLit{}
+	}
+
+	tags, err := reflect_.ParseAstStructTag(field.Tag.Value)
+	if err != nil {
+		panic(err)
+	}
+	tagOption, _ := tags.Get(TagOption)
+	if tagOption.Name == "-" {
+		// ignore this field
+		return StructField{}, false
+	}
+
+	var defaultValue string
+	defaultDirective, hasDefault := parseDefaultDirective(field.Doc, field.Comment)
+	defaultFrom := `"` + DirectiveOptionalDefault + defaultDirective + `"`
+	if defaultTag, ok := lookupDefaultTag(field.Tag.Value); ok {
+		if hasDefault {
+			log.Fatalf("field %s.%s: both %s and %s:%q set the default", typ, fieldName, defaultFrom, TagDefault, defaultTag)
+		}
+		defaultDirective, hasDefault = defaultTag, true
+		defaultFrom = fmt.Sprintf("%s:%q", TagDefault, defaultTag)
+	}
+	if hasDefault {
+		defaultValue, err = formatDefaultValue(defaultDirective, field.Type, f.pkg.types[field.Type].Type)
+		if err != nil {
+			log.Fatalf("field %s.%s: %s: %s", typ, fieldName, defaultFrom, err)
+		}
+	}
+
+	durationPkg, _ := durationPackage(field.Type, f.pkg.types[field.Type].Type)
+	sampleValue, _ := formatSampleValue(fieldName, field.Type, f.pkg.types[field.Type].Type)
+	fieldType, fieldIsMap, fieldSliceElt := FilterTypeName(field.Type)
+	var fieldPointerElt string
+	if star, ok := field.Type.(*ast.StarExpr); ok {
+		fieldPointerElt = types.ExprString(star.X)
+	}
+	return StructField{
+		FieldName:        fieldName,
+		FieldType:        fieldType,
+		FieldDocComment:  field.Doc,
+		FieldLineComment: field.Comment,
+		OptionTag:        tagOption,
+		FieldSliceElt:    fieldSliceElt,
+		FieldIsMap:       fieldIsMap,
+		FieldPointerElt:  fieldPointerElt,
+		DefaultDirective: defaultDirective,
+		DefaultFrom:      defaultFrom,
+		DefaultValue:     defaultValue,
+		FieldJSONTag:     tags.SelectAstString("json"),
+		DurationPackage:  durationPkg,
+		SampleValue:      sampleValue,
+	}, true
+}
+
+// promotedFields returns the fields promoted from the structs embedded in st of type typ, as Go promotes them,
+// so that they are set through the promoted field names, such as o.Name for Name of an embedded Base.
+// A field hides the fields of the same name embedded deeper, and fields of the same name at the same depth
+// are ambiguous, none of them promoted, as are the fields of a struct embedded along more than one path,
+// such as by two structs embedded side by side. Only the structs declared in the package and embedded by value,
+// not by pointer which may be nil, are descended into, and the fields tagged `option:"-"` stop the descent.
+func (f *File) promotedFields(typ string, st *ast.StructType) []StructField {
+	// the names of the fields at depth 0, hiding the promoted ones
+	hidden := make(map[string]bool)
+	for _, field := range st.Fields.List {
+		for _, name := range fieldNames(field) {
+			hidden[name] = true
+		}
+	}
+
+	var promoted []StructField
+	// the structs embedded at the depths above, whose fields are promoted or hidden already
+	seen := map[string]bool{typ: true}
+	embedded := f.embeddedStructs(nil, st, 1, seen)
+	for len(embedded) > 0 {
+		var fields []*ast.Field
+		count := make(map[string]int)
+		for _, e := range embedded {
+			seen[e.name] = true
+			for _, field := range e.st.Fields.List {
+				fields = append(fields, field)
+				for _, name := range fieldNames(field) {
+					count[name] += e.paths
+				}
+			}
+		}
+		var next []embeddedStruct
+		for _, e := range embedded {
+			next = f.embeddedStructs(next, e.st, e.paths, seen)
+		}
+		for _, field := range fields {
+			names := fieldNames(field)
+			if len(names) == 0 {
+				continue
+			}
+			// pick first exported Name, as for the fields at depth 0
+			var fieldName string
+			for _, name := range names {
+				if !*flagSkipPrivateFields || ast.IsExported(name) {
+					fieldName = name
+					break
+				}
+			}
+			if len(field.Names) == 0 {
+				// anonymous field, as for the fields at depth 0
+				if _, ok := field.Type.(*ast.Ident); !ok || *flagSkipAnonymousFields {
+					fieldName = ""
+				}
+			}
+			if fieldName == "" || hidden[fieldName] || count[fieldName] > 1 {
+				continue
+			}
+			if sf, ok := f.structField(typ, fieldName, field); ok {
+				promoted = append(promoted, sf)
+			}
+		}
+		for name := range count {
+			hidden[name] = true
+		}
+		embedded = next
+	}
+	return promoted
+}
+
+// embeddedStruct is a struct embedded at some depth, reached by paths embedding paths of embedded structs,
+// so that its fields are ambiguous if reached by more than one.
+type embeddedStruct struct {
+	name  string
+	st    *ast.StructType
+	paths int
+}
+
+// embeddedStructs appends to embedded the structs declared in the package and embedded by value in st,
+// which is reached by paths, but for the ones tagged `option:"-"` or seen at a depth above,
+// such as embedding each other. A struct appended already is reached by paths more.
+func (f *File) embeddedStructs(embedded []embeddedStruct, st *ast.StructType, paths int, seen map[string]bool) []embeddedStruct {
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 0 {
+			continue
+		}
+		ident, ok := field.Type.(*ast.Ident)
+		if !ok || seen[ident.Name] {
+			continue
+		}
+		if field.Tag != nil {
 			tags, err := reflect_.ParseAstStructTag(field.Tag.Value)
-			if err != nil {
-				panic(err)
+			if err == nil {
+				if tagOption, _ := tags.Get(TagOption); tagOption.Name == "-" {
+					continue
+				}
 			}
-			tagOption, _ := tags.Get(TagOption)
-			if tagOption.Name == "-" {
-				// ignore this field
+		}
+		if i := slices.IndexFunc(embedded, func(e embeddedStruct) bool { return e.name == ident.Name }); i >= 0 {
+			embedded[i].paths += paths
+			continue
+		}
+		if st := f.pkg.lookupStruct(ident.Name); st != nil {
+			embedded = append(embedded, embeddedStruct{name: ident.Name, st: st, paths: paths})
+		}
+	}
+	return embedded
+}
+
+// fieldNames returns the names field declares, or the name of the type for an anonymous field.
+func fieldNames(field *ast.Field) []string {
+	if len(field.Names) == 0 {
+		if ident := fieldNameIndent(field.Type); ident != nil {
+			return []string{ident.Name}
+		}
+		return nil
+	}
+	var names []string
+	for _, name := range field.Names {
+		names = append(names, name.Name)
+	}
+	return names
+}
+
+// lookupStruct returns the struct type declared as name in the package, without type parameters, nil if none.
+func (p *Package) lookupStruct(name string) *ast.StructType {
+	for _, file := range p.files {
+		if file.file == nil {
+			continue
+		}
+		for _, decl := range file.file.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
 				continue
 			}
+			for _, spec := range decl.Specs {
+				tspec := spec.(*ast.TypeSpec)
+				if tspec.Name.Name != name || tspec.TypeParams != nil {
+					continue
+				}
+				if st, ok := tspec.Type.(*ast.StructType); ok {
+					return st
+				}
+			}
+		}
+	}
+	return nil
+}
 
-			v.Fields = append(v.Fields, StructField{
-				FieldName:        fieldName,
-				FieldType:        fieldType,
-				FieldDocComment:  field.Doc,
-				FieldLineComment: field.Comment,
-				OptionTag:        tagOption,
-				FieldSliceElt:    fieldSliceElt,
-				FieldIsMap:       fieldIsMap,
-			})
+// fileBuildConstraints returns the //go:build and // +build lines of file, in order,
+// so that the files generated from it build under the same constraints.
+func fileBuildConstraints(file *ast.File) []string {
+	var lines []string
+	for _, cg := range file.Comments {
+		if cg.Pos() >= file.Package {
+			break
+		}
+		for _, c := range cg.List {
+			if constraint.IsGoBuild(c.Text) || constraint.IsPlusBuild(c.Text) {
+				lines = append(lines, c.Text)
+			}
 		}
-		f.structs = append(f.structs, v)
 	}
-	return false
+	return lines
+}
+
+// isDefaultDirective reports whether the comment c is a +optional-default directive.
+func isDefaultDirective(c *ast.Comment) bool {
+	return strings.HasPrefix(strings.TrimSpace(strings.TrimPrefix(c.Text, "//")), DirectiveOptionalDefault)
+}
+
+// parseDefaultDirective returns the value of the +optional-default directive in comments, if any.
+func parseDefaultDirective(comments ...*ast.CommentGroup) (value string, ok bool) {
+	for _, cg := range comments {
+		if cg == nil {
+			continue
+		}
+		for _, c := range cg.List {
+			if isDefaultDirective(c) {
+				text := strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))
+				return strings.TrimSpace(strings.TrimPrefix(text, DirectiveOptionalDefault)), true
+			}
+		}
+	}
+	return "", false
+}
+
+// lookupDefaultTag returns the value of the default tag in the struct tag literal tag, if any.
+func lookupDefaultTag(tag string) (value string, ok bool) {
+	tag, err := strconv.Unquote(tag)
+	if err != nil {
+		return "", false
+	}
+	return reflect.StructTag(tag).Lookup(TagDefault)
+}
+
+// formatDefaultValue parses value as the type typ of a field declared by expr,
+// and returns the Go expression of it, qualified by the package name used in expr if imported.
+// Supported are time.Duration and types of boolean, string and numeric underlying types.
+func formatDefaultValue(value string, expr ast.Expr, typ types.Type) (string, error) {
+	if typ == nil {
+		return "", fmt.Errorf("unknown type %s", types.ExprString(expr))
+	}
+	if qualifier, ok := durationPackage(expr, typ); ok {
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			return "", err
+		}
+		return formatDuration(d, qualifier), nil
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return "", fmt.Errorf("unsupported type %s, only time.Duration, booleans, strings and numbers have defaults", typ)
+	}
+	bits := 64
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		bits = 8
+	case types.Int16, types.Uint16:
+		bits = 16
+	case types.Int32, types.Uint32, types.Float32:
+		bits = 32
+	}
+	info := basic.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatBool(b), nil
+	case info&types.IsString != 0:
+		if s, err := strconv.Unquote(value); err == nil {
+			value = s
+		}
+		return strconv.Quote(value), nil
+	case info&types.IsUnsigned != 0:
+		u, err := strconv.ParseUint(value, 0, bits)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(u, 10), nil
+	case info&types.IsInteger != 0:
+		i, err := strconv.ParseInt(value, 0, bits)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatInt(i, 10), nil
+	case info&types.IsFloat != 0:
+		f, err := strconv.ParseFloat(value, bits)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatFloat(f, 'g', -1, bits), nil
+	}
+	return "", fmt.Errorf("unsupported type %s, only time.Duration, booleans, strings and numbers have defaults", typ)
+}
+
+// formatSampleValue returns the Go expression of a value other than zero of the type typ of the field name
+// declared by expr, such as to assert that the option of the field sets it, and ok false unless typ is
+// time.Duration or of boolean, string or numeric underlying type.
+func formatSampleValue(name string, expr ast.Expr, typ types.Type) (_ string, ok bool) {
+	if typ == nil {
+		return "", false
+	}
+	if qualifier, ok := durationPackage(expr, typ); ok {
+		return formatDuration(5*time.Second, qualifier), true
+	}
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return "", false
+	}
+	info := basic.Info()
+	switch {
+	case info&types.IsBoolean != 0:
+		return "true", true
+	case info&types.IsString != 0:
+		return strconv.Quote(name), true
+	case info&types.IsInteger != 0:
+		return "7", true
+	case info&types.IsFloat != 0, info&types.IsComplex != 0:
+		return "1.5", true
+	}
+	return "", false
+}
+
+// durationPackage reports whether typ of a field declared by expr is time.Duration,
+// and returns the package name used in expr for time, such as time_ for time_.Duration.
+func durationPackage(expr ast.Expr, typ types.Type) (qualifier string, ok bool) {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return "", false
+	}
+	if obj := named.Obj(); obj.Pkg() == nil || obj.Pkg().Path() != "time" || obj.Name() != "Duration" {
+		return "", false
+	}
+	qualifier = "time"
+	if sel, ok := expr.(*ast.SelectorExpr); ok {
+		if x, ok := sel.X.(*ast.Ident); ok {
+			qualifier = x.Name
+		}
+	}
+	return qualifier, true
+}
+
+// formatDuration returns d as a multiple of the largest unit of package qualifier dividing it, such as 5 * time.Second.
+func formatDuration(d time.Duration, qualifier string) string {
+	if d == 0 {
+		return "0"
+	}
+	for _, unit := range []struct {
+		name string
+		d    time.Duration
+	}{
+		{"Hour", time.Hour},
+		{"Minute", time.Minute},
+		{"Second", time.Second},
+		{"Millisecond", time.Millisecond},
+		{"Microsecond", time.Microsecond},
+	} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d * %s.%s", d/unit.d, qualifier, unit.name)
+		}
+	}
+	return fmt.Sprintf("%d * %s.Nanosecond", d, qualifier)
 }
@@ -0,0 +1,118 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package option
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"slices"
+	"testing"
+)
+
+func TestLookupDefaultTag(t *testing.T) {
+	tests := []struct {
+		tag    string
+		want   string
+		wantOk bool
+	}{
+		{tag: "", wantOk: false},
+		{tag: "`json:\"name\"`", wantOk: false},
+		{tag: "`default:\"5s\"`", want: "5s", wantOk: true},
+		{tag: "`json:\"hosts\" default:\"localhost,127.0.0.1\"`", want: "localhost,127.0.0.1", wantOk: true},
+		{tag: "`default:\"\"`", want: "", wantOk: true},
+	}
+	for _, tt := range tests {
+		got, ok := lookupDefaultTag(tt.tag)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("lookupDefaultTag(%s) = %q, %t, want %q, %t", tt.tag, got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestFormatDefaultValue(t *testing.T) {
+	duration := types.NewNamed(types.NewTypeName(0, types.NewPackage("time", "time"), "Duration", nil),
+		types.Typ[types.Int64], nil)
+	tests := []struct {
+		value   string
+		expr    ast.Expr
+		typ     types.Type
+		want    string
+		wantErr bool
+	}{
+		{value: "1m30s", expr: &ast.SelectorExpr{X: ast.NewIdent("time_"), Sel: ast.NewIdent("Duration")}, typ: duration,
+			want: "90 * time_.Second"},
+		{value: "localhost", expr: ast.NewIdent("string"), typ: types.Typ[types.String], want: `"localhost"`},
+		{value: "0x10", expr: ast.NewIdent("uint8"), typ: types.Typ[types.Uint8], want: "16"},
+		{value: "true", expr: ast.NewIdent("bool"), typ: types.Typ[types.Bool], want: "true"},
+		{value: "256", expr: ast.NewIdent("uint8"), typ: types.Typ[types.Uint8], wantErr: true},
+		{value: "yes", expr: ast.NewIdent("bool"), typ: types.Typ[types.Bool], wantErr: true},
+		{value: "a", expr: ast.NewIdent("[]string"), typ: types.NewSlice(types.Typ[types.String]), wantErr: true},
+		{value: "a", expr: ast.NewIdent("error"), typ: types.Universe.Lookup("error").Type(), wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := formatDefaultValue(tt.value, tt.expr, tt.typ)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("formatDefaultValue(%q, %s) error = %v, wantErr %t", tt.value, tt.typ, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("formatDefaultValue(%q, %s) = %q, want %q", tt.value, tt.typ, got, tt.want)
+		}
+	}
+}
+
+func TestFormatSampleValue(t *testing.T) {
+	duration := types.NewNamed(types.NewTypeName(0, types.NewPackage("time", "time"), "Duration", nil),
+		types.Typ[types.Int64], nil)
+	level := types.NewNamed(types.NewTypeName(0, types.NewPackage("main", "main"), "Level", nil),
+		types.Typ[types.Int8], nil)
+	tests := []struct {
+		expr   ast.Expr
+		typ    types.Type
+		want   string
+		wantOk bool
+	}{
+		{expr: &ast.SelectorExpr{X: ast.NewIdent("time"), Sel: ast.NewIdent("Duration")}, typ: duration,
+			want: "5 * time.Second", wantOk: true},
+		{expr: ast.NewIdent("string"), typ: types.Typ[types.String], want: `"Name"`, wantOk: true},
+		{expr: ast.NewIdent("Level"), typ: level, want: "7", wantOk: true},
+		{expr: ast.NewIdent("bool"), typ: types.Typ[types.Bool], want: "true", wantOk: true},
+		{expr: ast.NewIdent("float32"), typ: types.Typ[types.Float32], want: "1.5", wantOk: true},
+		{expr: ast.NewIdent("[]string"), typ: types.NewSlice(types.Typ[types.String]), wantOk: false},
+		{expr: ast.NewIdent("*int"), typ: types.NewPointer(types.Typ[types.Int]), wantOk: false},
+		{expr: ast.NewIdent("T"), typ: nil, wantOk: false},
+	}
+	for _, tt := range tests {
+		got, ok := formatSampleValue("Name", tt.expr, tt.typ)
+		if got != tt.want || ok != tt.wantOk {
+			t.Errorf("formatSampleValue(%s) = %q, %t, want %q, %t", types.ExprString(tt.expr), got, ok, tt.want, tt.wantOk)
+		}
+	}
+}
+
+func TestFileBuildConstraints(t *testing.T) {
+	tests := []struct {
+		src  string
+		want []string
+	}{
+		{src: "package main\n", want: nil},
+		{src: "// Copyright\n\n//go:build linux\n\n// Package main.\npackage main\n", want: []string{"//go:build linux"}},
+		{src: "//go:build linux && amd64\n// +build linux,amd64\n\npackage main\n",
+			want: []string{"//go:build linux && amd64", "// +build linux,amd64"}},
+		// a constraint after the package clause constrains nothing
+		{src: "package main\n\n//go:build linux\n", want: nil},
+	}
+	for _, tt := range tests {
+		f, err := parser.ParseFile(token.NewFileSet(), "", tt.src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fileBuildConstraints(f); !slices.Equal(got, tt.want) {
+			t.Errorf("fileBuildConstraints(%q) = %q, want %q", tt.src, got, tt.want)
+		}
+	}
+}
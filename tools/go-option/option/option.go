@@ -28,6 +28,7 @@ var (
 	flagSkipPrivateFields   = flag.Bool("skip-unexported", false, "skip unexported Fields")
 	flagSkipAnonymousFields = flag.Bool("skip-anonymous", false, "skip anonymous Fields")
 	trimPrefix              = flag.String("trimprefix", "", "trim the `prefix` from the generated constant names")
+	prefix                  = flag.String("prefix", "", "replace With<Type> by `prefix` in the names of the generated options setting fields, such as Set for Set<Field>")
 	trim                    = flag.Bool("trim", false, "trim type names as prefix from the generated constant names")
 	lineComment             = flag.Bool("linecomment", false, "use line comment text as printed text when present")
 	buildTags               = flag.String("tags", "", "comma-separated list of build tags to apply")
@@ -35,6 +36,16 @@ var (
 	config                  = flag.Bool("config", false, "generate completed config for type names")
 	optionOnly              = flag.Bool("optiononly", false, "generate option, mute config; overwrite flags --config and --option; --optionOnly and --configOnly can not both be set")
 	configOnly              = flag.Bool("configonly", false, "generate config, mute option; overwrite flags --config and --option; --optionOnly and --configOnly can not both be set")
+	locked                  = flag.Bool("locked", false, "guard ApplyOptions by the sync.Mutex field of type names and generate Snapshot for readers")
+	named                   = flag.Bool("named", false, "generate options recording the names of the fields they set, reported to a hook by ApplyOptions")
+	patch                   = flag.Bool("patch", false, "generate a <Type>Patch struct of pointers to the fields of type names, applied by ApplyPatch")
+	di                      = flag.Bool("di", false, "generate Provide<Type> constructors and uber/fx options for dependency injection of type names")
+	validate                = flag.Bool("validate", false, "generate options able to fail, applied by ApplyOptionsReturnError stopping at the first error")
+	fixedArity              = flag.Int("fixed-arity", 0, "generate ApplyOptions1 to ApplyOptions`N`, applying a fixed number of options without a variadic slice")
+	builder                 = flag.Bool("builder", false, "generate a <Type>Builder setting the fields of type names by chained calls, terminated by Build")
+	durationHelpers         = flag.Bool("durationhelpers", false, "generate options setting the time.Duration fields of type names to a number of seconds or milliseconds")
+	getters                 = flag.Bool("getters", false, "generate a Get<Field> method of type names per field option, reading the field back")
+	genTest                 = flag.Bool("gentest", false, "generate a <type>_options_test.go asserting that the options of type names set their fields")
 )
 
 // Usage is a replacement usage function for the flags package.
@@ -205,6 +216,9 @@ type Package struct {
 	// Invariant: Defs[id] == nil || Defs[id].Pos() == id.Pos()
 	defs map[*ast.Ident]types.Object
 
+	// Types maps expressions to their types, used to parse the +optional-default directive of fields.
+	types map[ast.Expr]types.TypeAndValue
+
 	// Ast files to which this package contains.
 	files []*File
 }
@@ -234,6 +248,7 @@ func (g *Generator) addPackage(pkg *packages.Package) {
 	g.pkg = &Package{
 		name:  pkg.Name,
 		defs:  pkg.TypesInfo.Defs,
+		types: pkg.TypesInfo.Types,
 		files: make([]*File, len(pkg.Syntax)),
 	}
 
@@ -267,9 +282,12 @@ func (g *Generator) inspect(typeInfo typeInfo) Struct {
 			})
 			importExps = slices_.Filter(importExps)
 
+			buildConstraints := fileBuildConstraints(file.file)
+
 			ast.Inspect(file.file, file.genDecl)
 			for i := range file.structs {
 				file.structs[i].FileImports = importExps
+				file.structs[i].FileBuildConstraints = buildConstraints
 			}
 
 			structs = append(structs, file.structs...)
@@ -279,6 +297,14 @@ func (g *Generator) inspect(typeInfo typeInfo) Struct {
 	if len(structs) == 0 {
 		log.Fatalf("no values defined for type %+v", typeInfo)
 	}
+	if *locked {
+		if structs[0].LockFieldName == "" {
+			log.Fatalf("-locked requires a sync.Mutex field in type %+v", typeInfo)
+		}
+		if strings.TrimSpace(typeInfo.Import) != "" {
+			log.Fatalf("-locked applies only to types defined in the package, not to imported type %+v", typeInfo)
+		}
+	}
 	return structs[0]
 }
 
@@ -349,16 +375,31 @@ func (g *Generator) generateOptionOneRun(dir string, value Struct) {
 		GoOptionToolArgs:             os.Args[1:],
 		PackageName:                  g.pkg.name,
 		ImportPaths:                  value.FileImports,
+		BuildConstraints:             value.FileBuildConstraints,
 		TargetTypeName:               value.StructTypeName,
 		TargetTypeImport:             value.StructTypeImport,
 		TargetTypeGenericDeclaration: value.StructTypeGenericDeclaration,
 		TargetTypeGenericParams:      value.StructTypeGenericTypeParams,
 		TrimmedTypeName:              value.trimmedStructTypeName,
+		Prefix:                       *prefix,
 		Fields:                       value.Fields,
+		LockFieldName:                value.LockFieldName,
+		SnapshotFieldNames:           value.SnapshotFieldNames,
+		Named:                        *named,
+		Patch:                        *patch,
+		DI:                           *di,
+		Validate:                     *validate,
+		FixedArity:                   *fixedArity,
+		Builder:                      *builder,
+		DurationHelpers:              *durationHelpers,
+		Getters:                      *getters,
 		ApplyOptionsAsMemberFunction: false,
 	}
 
 	tmplRender.Complete()
+	if err := tmplRender.checkNames(); err != nil {
+		log.Fatal(err)
+	}
 	g.Reset()
 	g.Render(tmplOption, tmplRender)
 
@@ -377,6 +418,30 @@ func (g *Generator) generateOptionOneRun(dir string, value Struct) {
 	if err != nil {
 		log.Fatalf("writing output: %s", err)
 	}
+	if *genTest {
+		g.generateOptionTest(strings.TrimSuffix(outputName, ".go")+"_test.go", tmplRender)
+	}
+}
+
+// generateOptionTest produces the test of the options generated by tmplRender, written to outputName.
+func (g *Generator) generateOptionTest(outputName string, tmplRender TmplOptionRender) {
+	if tmplRender.TargetTypeGenericDeclaration != "" {
+		log.Printf("-gentest skips generic type %s, which has no type arguments to test with", tmplRender.TargetTypeName)
+		return
+	}
+	g.Reset()
+	g.Render(tmplOptionTest, tmplRender)
+
+	// Format the output.
+	src := g.format()
+
+	target := g.goimport(src)
+
+	// Write to file.
+	err := os.WriteFile(outputName, target, 0644)
+	if err != nil {
+		log.Fatalf("writing output: %s", err)
+	}
 }
 
 func (g *Generator) generateConfig(dir string, structs ...Struct) {
@@ -393,11 +458,13 @@ func (g *Generator) generateConfigOneRun(dir string, value Struct) {
 		GoOptionToolArgs:             os.Args[1:],
 		PackageName:                  g.pkg.name,
 		ImportPaths:                  value.FileImports,
+		BuildConstraints:             value.FileBuildConstraints,
 		TargetTypeName:               value.StructTypeName,
 		TargetTypeImport:             value.StructTypeImport,
 		TargetTypeGenericDeclaration: value.StructTypeGenericDeclaration,
 		TargetTypeGenericParams:      value.StructTypeGenericTypeParams,
 		TrimmedTypeName:              value.trimmedStructTypeName,
+		Prefix:                       *prefix,
 		ApplyOptionsAsMemberFunction: false,
 	}
 
@@ -18,15 +18,17 @@ type TmplConfigRender struct {
 	GoOptionToolArgs       []string
 	GoOptionToolArgsJoined string
 
-	PackageName string
-	ImportPaths []string
-	ValDecls    []string
+	PackageName      string
+	ImportPaths      []string
+	ValDecls         []string
+	BuildConstraints []string // the //go:build and // +build lines of the file declaring the target type
 
 	TargetTypeName               string // type name of target type
 	TargetTypeImport             string // import path of target type
 	TargetTypeGenericDeclaration string // the Generic type of the struct type
 	TargetTypeGenericParams      string // the Generic params of the struct type
 	TrimmedTypeName              string // trimmed type name of target type
+	Prefix                       string // prefix of the options setting fields, With<Type> if empty, set by -prefix
 
 	OptionInterfaceName string // option interface name of target type
 	OptionStructName    string // option struct name of target type
@@ -40,9 +42,10 @@ func (t *TmplConfigRender) Complete() {
 
 	t.OptionInterfaceName = strings_.UpperCamelCaseSlice("option")
 	t.OptionStructName = strings_.UpperCamelCaseSlice("config")
-	if !*trim && t.TrimmedTypeName != "" {
-		t.OptionInterfaceName = strings_.UpperCamelCaseSlice(t.TrimmedTypeName, "option")
-		t.OptionStructName = strings_.UpperCamelCaseSlice(t.TrimmedTypeName, "config")
+	typeName, _, _ := optionNames(t.TrimmedTypeName, t.Prefix)
+	if !*trim && typeName != "" {
+		t.OptionInterfaceName = strings_.UpperCamelCaseSlice(typeName, "option")
+		t.OptionStructName = strings_.UpperCamelCaseSlice(typeName, "config")
 	}
 
 	importPath := strings.TrimSpace(t.TargetTypeImport)
@@ -59,6 +62,11 @@ func (t *TmplConfigRender) Complete() {
 
 const tmplConfig = `// Code generated by "{{.GoOptionToolName}} {{.GoOptionToolArgsJoined}}"; EDIT IT ANYWAY.
 // Install {{.GoOptionToolName}} by "go get install github.com/searKing/golang/tools/{{.GoOptionToolName}}"
+{{- if .BuildConstraints }}
+{{ range .BuildConstraints }}
+{{.}}
+{{- end}}
+{{- end}}
 
 package {{.PackageName}} 
 
@@ -0,0 +1,61 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package option
+
+// tmplOptionTest renders the test of the options rendered by tmplOption, see flag -gentest.
+// The options of the fields of simple kinds, with a SampleValue, are asserted to set their fields.
+const tmplOptionTest = `// Code generated by "{{.GoOptionToolName}} {{.GoOptionToolArgsJoined}}"; DO NOT EDIT.
+// Install {{.GoOptionToolName}} by "go get install github.com/searKing/golang/tools/{{.GoOptionToolName}}"
+{{- if .BuildConstraints }}
+{{ range .BuildConstraints }}
+{{.}}
+{{- end}}
+{{- end}}
+{{ $package_scope := . }}
+
+package {{.PackageName}}
+
+import (
+	"reflect"
+	"testing"
+)
+
+{{range $path := .ImportPaths}}
+import {{$path}}
+{{end}}
+
+// Test{{.FormatTypeName}}Options tests that the option of each field of {{.TargetTypeName}} of a simple kind sets the field.
+func Test{{.FormatTypeName}}Options(t *testing.T) {
+{{- range .Fields}}
+{{- if .SampleValue }}
+	t.Run("{{$package_scope.SetterPrefix}}{{.FormatFieldName}}", func(t *testing.T) {
+		var o {{$package_scope.TargetTypeName}}
+		var want {{.FieldType}} = {{.SampleValue}}
+{{- if $package_scope.ApplyOptionsAsMemberFunction }}
+		o.ApplyOptions({{$package_scope.SetterPrefix}}{{.FormatFieldName}}(want))
+{{- else}}
+		ApplyOptions(&o, {{$package_scope.SetterPrefix}}{{.FormatFieldName}}(want))
+{{- end}}
+		if o.{{.FieldName}} != want {
+			t.Errorf("{{$package_scope.SetterPrefix}}{{.FormatFieldName}}(%v): got %v, want %v", want, o.{{.FieldName}}, want)
+		}
+	})
+{{- end}}
+{{- end}}
+}
+
+// Test{{.FormatTypeName}}ApplyOptionsNil tests that a nil option leaves {{.TargetTypeName}} unchanged.
+func Test{{.FormatTypeName}}ApplyOptionsNil(t *testing.T) {
+	var got, want {{.TargetTypeName}}
+{{- if .ApplyOptionsAsMemberFunction }}
+	got.ApplyOptions(nil)
+{{- else}}
+	ApplyOptions(&got, nil)
+{{- end}}
+	if !reflect.DeepEqual(&got, &want) {
+		t.Errorf("ApplyOptions(nil): got %+v, want %+v", &got, &want)
+	}
+}
+`
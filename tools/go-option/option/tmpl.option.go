@@ -20,29 +20,53 @@ type TmplOptionRender struct {
 	GoOptionToolArgs       []string
 	GoOptionToolArgsJoined string
 
-	PackageName string
-	ImportPaths []string
-	ValDecls    []string
+	PackageName      string
+	ImportPaths      []string
+	ValDecls         []string
+	BuildConstraints []string // the //go:build and // +build lines of the file declaring the target type
 
 	TargetTypeName               string // type name of target type
 	TargetTypeImport             string // import path of target type
 	TargetTypeGenericDeclaration string // the Generic type of the struct type
 	TargetTypeGenericParams      string // the Generic params of the struct type
 	TrimmedTypeName              string // trimmed type name of target type
+	Prefix                       string // prefix of the options setting fields, With<Type> if empty, set by -prefix
 
 	FormatTypeName string        // The format FieldName of the struct type.
+	DefaultValName string        // The name of the default value var of the struct type, reset to by With<Type>Defaults.
 	Fields         []StructField // fields if target type is struct
 
 	OptionInterfaceName string // option interface name of target type
 	OptionStructName    string // option struct name of target type
+	SetterPrefix        string // prefix of the options setting fields before FormatFieldName, With unless set by -prefix
+
+	LockFieldName      string             // name of the sync.Mutex field guarding ApplyOptions, set if -locked
+	Named              bool               // options record the names of the fields they set, set if -named
+	Patch              bool               // generate <Type>Patch and ApplyPatch, set if -patch
+	DI                 bool               // generate Provide<Type> and the uber/fx options, set if -di
+	DIGroupName        string             // name of the uber/fx value group options are collected from, set if -di
+	Validate           bool               // options may fail by applyCheck, applied by ApplyOptionsReturnError, set if -validate
+	FixedArity         int                // generate ApplyOptions1 to ApplyOptions<FixedArity>, set by -fixed-arity
+	Builder            bool               // generate <Type>Builder, setting fields by chained calls, set if -builder
+	DurationHelpers    bool               // generate <Field>Seconds and <Field>Millis for time.Duration fields, set if -durationhelpers
+	Getters            bool               // generate a Get<Field> method per field, unless the type is imported, set if -getters
+	FixedArities       []FixedArityParams // the parameters of ApplyOptions1 to ApplyOptions<FixedArity>
+	SnapshotFieldNames []string           // fields copied by Snapshot, all fields but LockFieldName
 
 	ApplyOptionsAsMemberFunction bool // ApplyOptions can be registered as OptionType's member function
 	WithTargetTypeNameAsPrefix   bool // WithXXX() can be generated as {{OptionType}}WithXXX()
 }
 
+// FixedArityParams holds the parameters of ApplyOptions<N>, applying N options without a variadic slice.
+type FixedArityParams struct {
+	N      int      // the number of options applied
+	Params []string // the names of the options, opt1 to opt<N>
+}
+
 // Struct represents a declared constant.
 type Struct struct {
 	FileImports                  []string // The import path of the file contains the struct
+	FileBuildConstraints         []string // The //go:build and // +build lines of the file contains the struct
 	StructTypeImport             string   // The import path of StructTypeName.
 	StructTypeName               string   // The StructTypeName of the struct.
 	StructTypeGenericDeclaration string   // the Generic type of the struct type
@@ -50,6 +74,8 @@ type Struct struct {
 	trimmedStructTypeName        string   // The trimmed StructTypeName of the struct.
 	IsStruct                     bool
 	Fields                       []StructField
+	LockFieldName                string   // The name of the sync.Mutex field, set if -locked.
+	SnapshotFieldNames           []string // The names of all fields but LockFieldName, set if -locked.
 }
 
 type StructField struct {
@@ -60,9 +86,36 @@ type StructField struct {
 	OptionTag        reflect_.SubStructTag // The OptionTag of the struct field.
 	FieldIsMap       bool                  // The FieldType of the struct field is a map.
 	FieldSliceElt    string                // slice elt type name, for ...type_of_slice_element
+	FieldPointerElt  string                // pointer elt type name, for With<Field>Value(v type_of_pointer_element)
+	DefaultDirective string                // The value of the +optional-default directive or the default tag of the struct field.
+	DefaultFrom      string                // Where DefaultDirective is from, such as "+optional-default:5s" or default:"5s".
+	DefaultValue     string                // The Go expression of DefaultDirective, set if the directive or tag is present.
+	FieldJSONTag     string                // The json tag of the struct field, such as `json:"name,omitempty"`, kept by <Type>Patch.
+	DurationPackage  string                // The package name time is imported as, set if the struct field is a time.Duration.
+	SampleValue      string                // The Go expression of a value other than zero of the struct field, set if of a simple kind.
 
-	FormatFieldName     string   // The format FieldName of the struct field.
-	FormatFieldComments []string // The format comment of the struct field.
+	FormatFieldName        string   // The format FieldName of the struct field.
+	FormatFieldComments    []string // The format comment of the struct field.
+	DefaultValName         string   // The name of the default value var of the struct field, set if DefaultValue is set.
+	AppendSetter           bool     // With<Field> appends to the slice or map field, unless tagged `option:",replace"`.
+	ReplaceSetter          bool     // The slice or map field is set by an option, unless tagged `option:",append"`.
+	ReplaceFormatFieldName string   // The format FieldName of the option setting the slice or map field, <Field>Replace or <Field> alone.
+	BuilderFieldName       string   // The format FieldName of the methods of <Type>Builder setting the field, without the type name.
+}
+
+// optionNames returns the type name naming the option interface, and the prefix and the type name naming
+// the options setting fields, as With<Type><Field>, overridden by prefix if not empty:
+// With<Name> names both of them after <Name> instead, and any other prefix, such as Set, replaces With<Type>
+// of the options setting fields as a whole, as in Set<Field>.
+func optionNames(trimmedTypeName, prefix string) (typeName, setterPrefix, setterTypeName string) {
+	prefix = strings.TrimSpace(prefix)
+	if prefix == "" {
+		return trimmedTypeName, "With", trimmedTypeName
+	}
+	if name, ok := strings.CutPrefix(prefix, "With"); ok {
+		return name, "With", name
+	}
+	return trimmedTypeName, prefix, ""
 }
 
 func (t *TmplOptionRender) Complete() {
@@ -71,44 +124,104 @@ func (t *TmplOptionRender) Complete() {
 
 	t.OptionInterfaceName = strings_.UpperCamelCaseSlice("option")
 	t.OptionStructName = strings_.UpperCamelCaseSlice("config")
-	if !*trim && t.TrimmedTypeName != "" {
-		t.OptionInterfaceName = strings_.UpperCamelCaseSlice(t.TrimmedTypeName, "option")
-		t.OptionStructName = strings_.UpperCamelCaseSlice(t.TrimmedTypeName, "config")
+	typeName, setterPrefix, setterTypeName := optionNames(t.TrimmedTypeName, t.Prefix)
+	t.SetterPrefix = setterPrefix
+	if !*trim && typeName != "" {
+		t.OptionInterfaceName = strings_.UpperCamelCaseSlice(typeName, "option")
+		t.OptionStructName = strings_.UpperCamelCaseSlice(typeName, "config")
 	}
 
 	importPath := strings.TrimSpace(t.TargetTypeImport)
 	if importPath != "" {
 		t.ImportPaths = append(t.ImportPaths, fmt.Sprintf("%q", importPath))
 	}
+	if t.DI {
+		t.ImportPaths = append(t.ImportPaths, fmt.Sprintf("%q", "go.uber.org/fx"))
+		t.DIGroupName = strings_.SnakeCase(t.TargetTypeName) + "_options"
+	}
 	t.ImportPaths = slices_.Filter(t.ImportPaths)
 
-	_, defaultValDecl := createValAndNameDecl(t.TargetTypeName)
+	defaultValName, defaultValDecl := createValAndNameDecl(t.TargetTypeName)
 	if defaultValDecl != "" {
 		t.ValDecls = append(t.ValDecls, defaultValDecl)
 	}
+	t.DefaultValName = defaultValName
 
 	t.FormatTypeName = strings_.ToUpperLeading(t.TargetTypeName)
 
+	t.FixedArities = nil
+	for n := 1; n <= t.FixedArity; n++ {
+		arity := FixedArityParams{N: n}
+		for i := 1; i <= n; i++ {
+			arity.Params = append(arity.Params, fmt.Sprintf("opt%d", i))
+		}
+		t.FixedArities = append(t.FixedArities, arity)
+	}
+
 	for i, field := range t.Fields {
 		t.Fields[i].FormatFieldName = strings_.UpperCamelCaseSlice(strings_.ValueOrDefault(field.OptionTag.Name, field.FieldName))
+		t.Fields[i].BuilderFieldName = t.Fields[i].FormatFieldName
 		if !field.OptionTag.HasOption(TagOptionFlagShort) {
-			t.Fields[i].FormatFieldName = strings_.ToUpperLeading(t.TrimmedTypeName) + t.Fields[i].FormatFieldName
+			t.Fields[i].FormatFieldName = strings_.ToUpperLeading(setterTypeName) + t.Fields[i].FormatFieldName
+		}
+		// both, unless only one of them is tagged
+		t.Fields[i].AppendSetter = !field.OptionTag.HasOption(TagOptionFlagReplace) || field.OptionTag.HasOption(TagOptionFlagAppend)
+		t.Fields[i].ReplaceSetter = !field.OptionTag.HasOption(TagOptionFlagAppend) || field.OptionTag.HasOption(TagOptionFlagReplace)
+		t.Fields[i].ReplaceFormatFieldName = t.Fields[i].FormatFieldName
+		if t.Fields[i].AppendSetter {
+			t.Fields[i].ReplaceFormatFieldName += "Replace"
 		}
 		if field.FieldDocComment != nil {
 			for _, c := range field.FieldDocComment.List {
+				if isDefaultDirective(c) {
+					continue
+				}
 				t.Fields[i].FormatFieldComments = append(t.Fields[i].FormatFieldComments, c.Text)
 			}
 		}
 		if field.FieldLineComment != nil {
 			for _, c := range field.FieldLineComment.List {
+				if isDefaultDirective(c) {
+					continue
+				}
 				t.Fields[i].FormatFieldComments = append(t.Fields[i].FormatFieldComments, c.Text)
 			}
 		}
+		if field.DefaultValue != "" {
+			t.Fields[i].DefaultValName = fmt.Sprintf("_default_%s_%s_value", t.TargetTypeName, field.FieldName)
+		}
+	}
+}
+
+// checkNames returns an error if the option setting a field is named as With<Type>Defaults,
+// resetting the whole type, such as for a field named Defaults, as the Go generated would not compile.
+// Call it after Complete.
+func (t *TmplOptionRender) checkNames() error {
+	if t.LockFieldName != "" {
+		// no With<Type>Defaults
+		return nil
+	}
+	resetName := "with" + t.FormatTypeName + "Defaults"
+	if ast.IsExported(t.TargetTypeName) {
+		resetName = "With" + t.FormatTypeName + "Defaults"
+	}
+	for _, field := range t.Fields {
+		if t.SetterPrefix+field.FormatFieldName == resetName {
+			return fmt.Errorf("field %s.%s: its option %s is named as the one resetting %s to its default, "+
+				"rename the option by the option tag, such as `option:\"<name>\"`",
+				t.TargetTypeName, field.FieldName, resetName, t.TargetTypeName)
+		}
 	}
+	return nil
 }
 
 const tmplOption = `// Code generated by "{{.GoOptionToolName}} {{.GoOptionToolArgsJoined}}"; DO NOT EDIT.
 // Install {{.GoOptionToolName}} by "go get install github.com/searKing/golang/tools/{{.GoOptionToolName}}"
+{{- if .BuildConstraints }}
+{{ range .BuildConstraints }}
+{{.}}
+{{- end}}
+{{- end}}
 {{ $package_scope := . }}
 
 package {{.PackageName}} 
@@ -120,6 +233,11 @@ import {{$path}}
 // A {{.OptionInterfaceName}} sets options.
 type {{.OptionInterfaceName}}{{.TargetTypeGenericDeclaration}} interface {
 	apply(*{{.TargetTypeName}}{{.TargetTypeGenericParams}})
+{{- if .Validate }}
+	// applyCheck applies the option as apply does, and returns an error if the option is invalid,
+	// applied by ApplyOptionsReturnError.
+	applyCheck(*{{.TargetTypeName}}{{.TargetTypeGenericParams}}) error
+{{- end}}
 }
 
 // Empty{{.OptionInterfaceName}} does not alter the configuration. It can be embedded
@@ -129,6 +247,10 @@ type {{.OptionInterfaceName}}{{.TargetTypeGenericDeclaration}} interface {
 type Empty{{.OptionInterfaceName}}{{.TargetTypeGenericDeclaration}} struct{}
 
 func (Empty{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) apply(*{{.TargetTypeName}}{{.TargetTypeGenericParams}}) {}
+{{- if .Validate }}
+
+func (Empty{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) applyCheck(*{{.TargetTypeName}}{{.TargetTypeGenericParams}}) error { return nil }
+{{- end}}
 
 // {{.OptionInterfaceName}}Func wraps a function that modifies {{.TargetTypeName}}{{.TargetTypeGenericParams}} into an
 // implementation of the {{.OptionInterfaceName}}{{.TargetTypeGenericDeclaration}} interface.
@@ -138,7 +260,97 @@ func (f {{.OptionInterfaceName}}Func{{.TargetTypeGenericParams}}) apply(do *{{.T
 	f(do)
 }
 
-{{- if .ApplyOptionsAsMemberFunction }}
+{{- if .Validate }}
+
+func (f {{.OptionInterfaceName}}Func{{.TargetTypeGenericParams}}) applyCheck(do *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) error {
+	f(do)
+	return nil
+}
+
+// {{.OptionInterfaceName}}CheckFunc wraps a function that modifies {{.TargetTypeName}}{{.TargetTypeGenericParams}} and may fail, such as to validate
+// the value it sets, into an implementation of the {{.OptionInterfaceName}}{{.TargetTypeGenericDeclaration}} interface.
+// ApplyOptionsReturnError returns its error; ApplyOptions, which cannot report failure, ignores it.
+type {{.OptionInterfaceName}}CheckFunc{{.TargetTypeGenericDeclaration}} func(*{{.TargetTypeName}}{{.TargetTypeGenericParams}}) error
+
+func (f {{.OptionInterfaceName}}CheckFunc{{.TargetTypeGenericParams}}) apply(do *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) {
+	_ = f(do)
+}
+
+func (f {{.OptionInterfaceName}}CheckFunc{{.TargetTypeGenericParams}}) applyCheck(do *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) error {
+	return f(do)
+}
+{{- end}}
+
+{{- if .Named }}
+
+// {{.OptionInterfaceName}}Named wraps a {{.OptionInterfaceName}}Func{{.TargetTypeGenericParams}} with the name of the field it sets,
+// so that applied options can be told apart, such as for logging.
+type {{.OptionInterfaceName}}Named{{.TargetTypeGenericDeclaration}} struct {
+	{{.OptionInterfaceName}}Func{{.TargetTypeGenericParams}}
+	name string
+}
+
+// Name returns the name of the field the option sets.
+func (o {{.OptionInterfaceName}}Named{{.TargetTypeGenericParams}}) Name() string {
+	return o.name
+}
+
+func named{{.OptionInterfaceName}}{{.TargetTypeGenericDeclaration}}(name string, f {{.OptionInterfaceName}}Func{{.TargetTypeGenericParams}}) {{.OptionInterfaceName}}{{.TargetTypeGenericParams}} {
+	return {{.OptionInterfaceName}}Named{{.TargetTypeGenericParams}}{ {{- .OptionInterfaceName}}Func: f, name: name}
+}
+
+// {{.OptionInterfaceName}}Names returns the names of the fields set by options, in order,
+// skipping options not named.
+func {{.OptionInterfaceName}}Names{{.TargetTypeGenericDeclaration}}(options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) []string {
+	var names []string
+	for _, opt := range options {
+		if opt, ok := opt.(interface{ Name() string }); ok {
+			names = append(names, opt.Name())
+		}
+	}
+	return names
+}
+
+// {{.OptionInterfaceName}}AppliedHook, if not nil, is called by ApplyOptions
+// with the name of every named option applied, in order.
+var {{.OptionInterfaceName}}AppliedHook func(name string)
+{{- end}}
+
+{{- if .LockFieldName }}
+// ApplyOptions call apply() for all options one by one,
+// holding o.{{.LockFieldName}} so that options are applied atomically relative to Snapshot.
+func (o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) ApplyOptions(options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
+	o.{{.LockFieldName}}.Lock()
+	defer o.{{.LockFieldName}}.Unlock()
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+{{- if $.Named }}
+		if hook := {{$.OptionInterfaceName}}AppliedHook; hook != nil {
+			if opt, ok := opt.(interface{ Name() string }); ok {
+				hook(opt.Name())
+			}
+		}
+{{- end}}
+	}
+	return o
+}
+
+// Snapshot returns a copy of all fields but {{.LockFieldName}} of {{.TargetTypeName}}{{.TargetTypeGenericParams}},
+// taken while holding o.{{.LockFieldName}}.
+// Maps and slices are copied shallowly.
+func (o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) Snapshot() *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
+	o.{{.LockFieldName}}.Lock()
+	defer o.{{.LockFieldName}}.Unlock()
+	return &{{.TargetTypeName}}{{.TargetTypeGenericParams}}{
+	{{- range .SnapshotFieldNames}}
+		{{.}}: o.{{.}},
+	{{- end}}
+	}
+}
+{{- else if .ApplyOptionsAsMemberFunction }}
 // ApplyOptions call apply() for all options one by one
 func (o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) ApplyOptions(options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
 	for _, opt := range options {
@@ -146,6 +358,13 @@ func (o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) ApplyOptions(options .
 			continue
 		}
 		opt.apply(o)
+{{- if $.Named }}
+		if hook := {{$.OptionInterfaceName}}AppliedHook; hook != nil {
+			if opt, ok := opt.(interface{ Name() string }); ok {
+				hook(opt.Name())
+			}
+		}
+{{- end}}
 	}
 	return o
 }
@@ -157,12 +376,93 @@ func ApplyOptions{{.TargetTypeGenericDeclaration}}(o *{{.TargetTypeName}}{{.Targ
 			continue
 		}
 		opt.apply(o)
+{{- if $.Named }}
+		if hook := {{$.OptionInterfaceName}}AppliedHook; hook != nil {
+			if opt, ok := opt.(interface{ Name() string }); ok {
+				hook(opt.Name())
+			}
+		}
+{{- end}}
 	}
 	return o
 }
 {{- end}}
 
-{{- if .TargetTypeName | IsExported }}
+{{- range $arity := .FixedArities }}
+{{- if $package_scope.ApplyOptionsAsMemberFunction }}
+
+// ApplyOptions{{$arity.N}} call apply() for {{$arity.N}} option{{if gt $arity.N 1}}s one by one{{end}}, as ApplyOptions does,
+// but without the variadic slice of options, which escapes to the heap if ApplyOptions is called indirectly.
+{{- if $package_scope.LockFieldName }}
+// It holds o.{{$package_scope.LockFieldName}} so that options are applied atomically relative to Snapshot.
+{{- end}}
+func (o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) ApplyOptions{{$arity.N}}({{range $i, $p := $arity.Params}}{{if $i}}, {{end}}{{$p}}{{end}} {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}) *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}} {
+{{- if $package_scope.LockFieldName }}
+	o.{{$package_scope.LockFieldName}}.Lock()
+	defer o.{{$package_scope.LockFieldName}}.Unlock()
+{{- end}}
+{{- else}}
+
+// ApplyOptions{{$arity.N}} call apply() for {{$arity.N}} option{{if gt $arity.N 1}}s one by one{{end}}, as ApplyOptions does,
+// but without the variadic slice of options, which escapes to the heap if ApplyOptions is called indirectly.
+func ApplyOptions{{$arity.N}}{{$package_scope.TargetTypeGenericDeclaration}}(o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}, {{range $i, $p := $arity.Params}}{{if $i}}, {{end}}{{$p}}{{end}} {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}) *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}} {
+{{- end}}
+{{- range $arity.Params}}
+	if {{.}} != nil {
+		{{.}}.apply(o)
+{{- if $package_scope.Named }}
+		if hook := {{$package_scope.OptionInterfaceName}}AppliedHook; hook != nil {
+			if opt, ok := {{.}}.(interface{ Name() string }); ok {
+				hook(opt.Name())
+			}
+		}
+{{- end}}
+	}
+{{- end}}
+	return o
+}
+{{- end}}
+
+{{- if .Validate }}
+{{- if .ApplyOptionsAsMemberFunction }}
+
+// ApplyOptionsReturnError call applyCheck() for all options one by one, as ApplyOptions does,
+// and stops at the first option failing, returning its error, the options before it applied already.
+{{- if .LockFieldName }}
+// It holds o.{{.LockFieldName}} so that options are applied atomically relative to Snapshot.
+{{- end}}
+func (o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) ApplyOptionsReturnError(options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) error {
+{{- if .LockFieldName }}
+	o.{{.LockFieldName}}.Lock()
+	defer o.{{.LockFieldName}}.Unlock()
+{{- end}}
+{{- else}}
+
+// ApplyOptionsReturnError call applyCheck() for all options one by one, as ApplyOptions does,
+// and stops at the first option failing, returning its error, the options before it applied already.
+func ApplyOptionsReturnError{{.TargetTypeGenericDeclaration}}(o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}, options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) error {
+{{- end}}
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		if err := opt.applyCheck(o); err != nil {
+			return err
+		}
+{{- if $.Named }}
+		if hook := {{$.OptionInterfaceName}}AppliedHook; hook != nil {
+			if opt, ok := opt.(interface{ Name() string }); ok {
+				hook(opt.Name())
+			}
+		}
+{{- end}}
+	}
+	return nil
+}
+{{- end}}
+
+{{- if .LockFieldName }}
+{{- else if .TargetTypeName | IsExported }}
 // With{{.FormatTypeName}} sets {{.TargetTypeName}}.
 func With{{.FormatTypeName}}{{.TargetTypeGenericDeclaration}}(v {{.TargetTypeName}}{{.TargetTypeGenericParams}}) {{.OptionInterfaceName}}{{.TargetTypeGenericParams}} {
 	return {{.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) {
@@ -178,6 +478,112 @@ func with{{.FormatTypeName}}{{.TargetTypeGenericDeclaration}}(v {{.TargetTypeNam
 }
 {{- end}}
 
+{{- if not .LockFieldName }}
+{{- if not .TargetTypeGenericDeclaration }}
+
+// {{.DefaultValName}} is the default of {{.TargetTypeName}}, the zero value but for the fields with a default.
+var {{.DefaultValName}} = func() (val {{.TargetTypeName}}) {
+{{- range .Fields}}
+{{- if .DefaultValName }}
+	val.{{.FieldName}} = {{.DefaultValName}}
+{{- end}}
+{{- end}}
+	return
+}()
+{{- end}}
+
+// {{if .TargetTypeName | IsExported}}W{{else}}w{{end}}ith{{.FormatTypeName}}Defaults resets {{.TargetTypeName}}{{.TargetTypeGenericParams}} to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., With{{.FormatTypeName}}Defaults(), finalOverrides...).
+func {{if .TargetTypeName | IsExported}}W{{else}}w{{end}}ith{{.FormatTypeName}}Defaults{{.TargetTypeGenericDeclaration}}() {{.OptionInterfaceName}}{{.TargetTypeGenericParams}} {
+	return {{.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) {
+{{- if .TargetTypeGenericDeclaration }}
+		*o = {{.TargetTypeName}}{{.TargetTypeGenericParams}}{}
+{{- range .Fields}}
+{{- if .DefaultValName }}
+		o.{{.FieldName}} = {{.DefaultValName}}
+{{- end}}
+{{- end}}
+{{- else}}
+		*o = {{.DefaultValName}}
+{{- end}}
+	})
+}
+{{- end}}
+
+{{- if .Patch }}
+
+// {{.TargetTypeName}}Patch holds the fields of {{.TargetTypeName}}{{.TargetTypeGenericParams}} to update by ApplyPatch, as pointers:
+// a nil field is left unchanged, such as to apply a JSON Merge Patch decoded into it,
+// where null and absent members both leave the field unchanged.
+type {{.TargetTypeName}}Patch{{.TargetTypeGenericDeclaration}} struct {
+{{- range .Fields}}
+	{{.FieldName}} *{{.FieldType}} {{.FieldJSONTag}}
+{{- end}}
+}
+{{- if .ApplyOptionsAsMemberFunction }}
+
+// ApplyPatch sets the fields of o set in patch, leaving the others unchanged.
+{{- if .LockFieldName }}
+// It holds o.{{.LockFieldName}} so that patch is applied atomically relative to Snapshot.
+{{- end}}
+func (o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}) ApplyPatch(patch *{{.TargetTypeName}}Patch{{.TargetTypeGenericParams}}) *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
+{{- else}}
+
+// ApplyPatch sets the fields of o set in patch, leaving the others unchanged.
+func ApplyPatch{{.TargetTypeGenericDeclaration}}(o *{{.TargetTypeName}}{{.TargetTypeGenericParams}}, patch *{{.TargetTypeName}}Patch{{.TargetTypeGenericParams}}) *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
+{{- end}}
+	if patch == nil {
+		return o
+	}
+{{- if .LockFieldName }}
+	o.{{.LockFieldName}}.Lock()
+	defer o.{{.LockFieldName}}.Unlock()
+{{- end}}
+{{- range .Fields}}
+	if patch.{{.FieldName}} != nil {
+		o.{{.FieldName}} = *patch.{{.FieldName}}
+	}
+{{- end}}
+	return o
+}
+{{- end}}
+
+{{- if .DI }}
+
+// Provide{{.FormatTypeName}} returns a new {{.TargetTypeName}}{{.TargetTypeGenericParams}} with options applied, as a constructor
+// to provide to dependency injection frameworks, such as google/wire and uber/fx.
+func Provide{{.FormatTypeName}}{{.TargetTypeGenericDeclaration}}(opts ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
+{{- if .ApplyOptionsAsMemberFunction }}
+	return new({{.TargetTypeName}}{{.TargetTypeGenericParams}}).ApplyOptions(opts...)
+{{- else}}
+	return ApplyOptions(new({{.TargetTypeName}}{{.TargetTypeGenericParams}}), opts...)
+{{- end}}
+}
+
+// {{.OptionInterfaceName}}Group is the name of the uber/fx value group {{.OptionInterfaceName}}s are collected from
+// by {{.FormatTypeName}}FxOption.
+const {{.OptionInterfaceName}}Group = "{{.DIGroupName}}"
+
+// {{.FormatTypeName}}FxOption returns an fx.Option providing *{{.TargetTypeName}}{{.TargetTypeGenericParams}} built by Provide{{.FormatTypeName}}
+// with all the {{.OptionInterfaceName}}s in the value group {{.OptionInterfaceName}}Group, in no particular order.
+func {{.FormatTypeName}}FxOption{{.TargetTypeGenericDeclaration}}() fx.Option {
+	return fx.Provide(fx.Annotate(Provide{{.FormatTypeName}}{{.TargetTypeGenericParams}}, fx.ParamTags("group:\"{{.DIGroupName}}\"")))
+}
+
+// {{.OptionInterfaceName}}FxSupply returns an fx.Option contributing options to the value group {{.OptionInterfaceName}}Group,
+// to be applied by {{.FormatTypeName}}FxOption.
+func {{.OptionInterfaceName}}FxSupply{{.TargetTypeGenericDeclaration}}(options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) fx.Option {
+	var provides []fx.Option
+	for _, opt := range options {
+		opt := opt // captured by the constructor, whatever the go version of the module
+		provides = append(provides, fx.Provide(fx.Annotate(func() {{.OptionInterfaceName}}{{.TargetTypeGenericParams}} { return opt }, fx.ResultTags("group:\"{{.DIGroupName}}\""))))
+	}
+	return fx.Options(provides...)
+}
+{{- end}}
+
 {{- if not .Fields }}
 // sample code for option, default for nothing to change
 func _{{.OptionInterfaceName}}WithDefault{{.TargetTypeGenericDeclaration}}() {{.OptionInterfaceName}}{{.TargetTypeGenericParams}} {
@@ -196,31 +602,36 @@ func {{$package_scope.OptionInterfaceName}}With{{.FieldName}}{{$package_scope.Ta
 }
 {{- else}}
 {{- if .FieldSliceElt }}
-// With{{.FormatFieldName}} appends {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
+{{- if .AppendSetter }}
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}} appends {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
 {{- range .FormatFieldComments}}
 {{.}}
 {{- end}}
-func With{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(v ...{{.FieldSliceElt}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
-	return {{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(v ...{{.FieldSliceElt}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
 		o.{{.FieldName}} = append(o.{{.FieldName}}, v...)
-	})
+	}){{if $package_scope.Named}}){{end}}
 }
-// With{{.FormatFieldName}}Replace sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
+{{- end}}
+{{- if .ReplaceSetter }}
+// {{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}} sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
 {{- range .FormatFieldComments}}
 {{.}}
 {{- end}}
-func With{{.FormatFieldName}}Replace{{$package_scope.TargetTypeGenericDeclaration}}(v ...{{.FieldSliceElt}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
-	return {{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+func {{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(v ...{{.FieldSliceElt}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
 		o.{{.FieldName}} = v
-	})
+	}){{if $package_scope.Named}}){{end}}
 }
+{{- end}}
 {{- else if .FieldIsMap}}
-// With{{.FormatFieldName}} appends {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
+{{- if .AppendSetter }}
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}} appends {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
 {{- range .FormatFieldComments}}
 {{.}}
 {{- end}}
-func With{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(m {{.FieldType}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
-	return {{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(m {{.FieldType}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
 		if o.{{.FieldName}} == nil {
 			o.{{.FieldName}} = m
 			return
@@ -228,26 +639,161 @@ func With{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(m {
 		for k,v := range m {
 			o.{{.FieldName}}[k] = v
 		}
-	})
+	}){{if $package_scope.Named}}){{end}}
 }
-// With{{.FormatFieldName}}Replace sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
+{{- end}}
+{{- if .ReplaceSetter }}
+// {{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}} sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
 {{- range .FormatFieldComments}}
 {{.}}
 {{- end}}
-func With{{.FormatFieldName}}Replace{{$package_scope.TargetTypeGenericDeclaration}}(v {{.FieldType}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
-	return {{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+func {{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(v {{.FieldType}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
 		o.{{.FieldName}} = v
-	})
+	}){{if $package_scope.Named}}){{end}}
 }
+{{- end}}
 {{- else}}
-// With{{.FormatFieldName}} sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}} sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}.
 {{- range .FormatFieldComments}}
 {{.}}
 {{- end}}
-func With{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(v {{.FieldType}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
-	return {{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericDeclaration}}(v {{.FieldType}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
 		o.{{.FieldName}} = v
-	})
+	}){{if $package_scope.Named}}){{end}}
+}
+{{- if .FieldPointerElt }}
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Value sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}} to a pointer to a copy of v.
+{{- range .FormatFieldComments}}
+{{.}}
+{{- end}}
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Value{{$package_scope.TargetTypeGenericDeclaration}}(v {{.FieldPointerElt}}) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.{{.FieldName}} = &v
+	}){{if $package_scope.Named}}){{end}}
+}
+{{- end}}
+{{- if and $package_scope.DurationHelpers .DurationPackage }}
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Seconds sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}} to n seconds.
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Seconds{{$package_scope.TargetTypeGenericDeclaration}}(n int) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericParams}}({{.DurationPackage}}.Duration(n) * {{.DurationPackage}}.Second)
+}
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Millis sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}} to n milliseconds.
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Millis{{$package_scope.TargetTypeGenericDeclaration}}(n int) {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericParams}}({{.DurationPackage}}.Duration(n) * {{.DurationPackage}}.Millisecond)
+}
+{{- end}}
+{{- end}}
+{{- if .DefaultValName }}
+
+// {{.DefaultValName}} is the default of {{.FieldName}} in {{$package_scope.TargetTypeName}}, from {{.DefaultFrom}}.
+var {{.DefaultValName}} {{.FieldType}} = {{.DefaultValue}}
+
+// {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Default sets {{.FieldName}} in {{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}} to its default {{.DefaultDirective}}.
+func {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Default{{$package_scope.TargetTypeGenericDeclaration}}() {{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}} {
+	return {{if $package_scope.Named}}named{{$package_scope.OptionInterfaceName}}{{$package_scope.TargetTypeGenericParams}}("{{.FieldName}}", {{end}}{{$package_scope.OptionInterfaceName}}Func{{$package_scope.TargetTypeGenericParams}} (func( o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) {
+		o.{{.FieldName}} = {{.DefaultValName}}
+	}){{if $package_scope.Named}}){{end}}
+}
+{{- end}}
+{{- if and $package_scope.Getters $package_scope.ApplyOptionsAsMemberFunction }}
+
+// Get{{.BuilderFieldName}} returns {{.FieldName}} of o, as set by {{$package_scope.SetterPrefix}}{{.FormatFieldName}}.
+{{- if $package_scope.LockFieldName }}
+// It holds o.{{$package_scope.LockFieldName}}, as Snapshot does.
+{{- end}}
+func (o *{{$package_scope.TargetTypeName}}{{$package_scope.TargetTypeGenericParams}}) Get{{.BuilderFieldName}}() {{.FieldType}} {
+{{- if $package_scope.LockFieldName }}
+	o.{{$package_scope.LockFieldName}}.Lock()
+	defer o.{{$package_scope.LockFieldName}}.Unlock()
+{{- end}}
+	return o.{{.FieldName}}
+}
+{{- end}}
+{{- end}}
+{{- end}}
+
+{{- if .Builder }}
+
+// {{.TargetTypeName}}Builder builds a {{.TargetTypeName}}{{.TargetTypeGenericParams}} by chained calls, as an alternative to ApplyOptions:
+// its methods collect the options setting the fields, applied in order by Build.
+type {{.TargetTypeName}}Builder{{.TargetTypeGenericDeclaration}} struct {
+	options []{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}
+}
+
+// New{{.FormatTypeName}}Builder returns an empty {{.TargetTypeName}}Builder{{.TargetTypeGenericParams}}.
+func New{{.FormatTypeName}}Builder{{.TargetTypeGenericDeclaration}}() *{{.TargetTypeName}}Builder{{.TargetTypeGenericParams}} {
+	return &{{.TargetTypeName}}Builder{{.TargetTypeGenericParams}}{}
+}
+
+// With appends options to b, such as options written by hand, and returns b.
+func (b *{{.TargetTypeName}}Builder{{.TargetTypeGenericParams}}) With(options ...{{.OptionInterfaceName}}{{.TargetTypeGenericParams}}) *{{.TargetTypeName}}Builder{{.TargetTypeGenericParams}} {
+	b.options = append(b.options, options...)
+	return b
+}
+
+// Build returns a new {{.TargetTypeName}}{{.TargetTypeGenericParams}} with the options of b applied in order.
+// Build returns another {{.TargetTypeName}}{{.TargetTypeGenericParams}} each time it is called, though the slices and maps set by
+// the options of b are shared, as when the same options are applied twice.
+func (b *{{.TargetTypeName}}Builder{{.TargetTypeGenericParams}}) Build() *{{.TargetTypeName}}{{.TargetTypeGenericParams}} {
+{{- if .ApplyOptionsAsMemberFunction }}
+	return new({{.TargetTypeName}}{{.TargetTypeGenericParams}}).ApplyOptions(b.options...)
+{{- else}}
+	return ApplyOptions(new({{.TargetTypeName}}{{.TargetTypeGenericParams}}), b.options...)
+{{- end}}
+}
+{{- range .Fields}}
+{{- if .FieldSliceElt }}
+{{- if .AppendSetter }}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}} appends {{.FieldName}}, as {{$package_scope.SetterPrefix}}{{.FormatFieldName}} does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}(v ...{{.FieldSliceElt}}) *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericParams}}(v...))
+}
+{{- end}}
+{{- if .ReplaceSetter }}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}{{if .AppendSetter}}Replace{{end}} sets {{.FieldName}}, as {{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}} does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}{{if .AppendSetter}}Replace{{end}}(v ...{{.FieldSliceElt}}) *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}}{{$package_scope.TargetTypeGenericParams}}(v...))
+}
+{{- end}}
+{{- else if .FieldIsMap}}
+{{- if .AppendSetter }}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}} appends {{.FieldName}}, as {{$package_scope.SetterPrefix}}{{.FormatFieldName}} does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}(m {{.FieldType}}) *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericParams}}(m))
+}
+{{- end}}
+{{- if .ReplaceSetter }}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}{{if .AppendSetter}}Replace{{end}} sets {{.FieldName}}, as {{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}} does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}{{if .AppendSetter}}Replace{{end}}(v {{.FieldType}}) *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.ReplaceFormatFieldName}}{{$package_scope.TargetTypeGenericParams}}(v))
+}
+{{- end}}
+{{- else}}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}} sets {{.FieldName}}, as {{$package_scope.SetterPrefix}}{{.FormatFieldName}} does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}(v {{.FieldType}}) *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.FormatFieldName}}{{$package_scope.TargetTypeGenericParams}}(v))
+}
+{{- if .FieldPointerElt }}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}Value sets {{.FieldName}} to a pointer to a copy of v, as {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Value does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}Value(v {{.FieldPointerElt}}) *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.FormatFieldName}}Value{{$package_scope.TargetTypeGenericParams}}(v))
+}
+{{- end}}
+{{- end}}
+{{- if .DefaultValName }}
+
+// {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}Default sets {{.FieldName}} to its default, as {{$package_scope.SetterPrefix}}{{.FormatFieldName}}Default does, and returns b.
+func (b *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}}) {{$package_scope.SetterPrefix}}{{.BuilderFieldName}}Default() *{{$package_scope.TargetTypeName}}Builder{{$package_scope.TargetTypeGenericParams}} {
+	return b.With({{$package_scope.SetterPrefix}}{{.FormatFieldName}}Default{{$package_scope.TargetTypeGenericParams}}())
 }
 {{- end}}
 {{- end}}
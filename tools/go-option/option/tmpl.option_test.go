@@ -0,0 +1,451 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package option
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+	"testing"
+
+	reflect_ "github.com/searKing/golang/go/reflect"
+)
+
+// renderTest renders tmpl, tmplOption if empty, with render completed,
+// and checks the Go generated contains all of want, and none of notWant.
+// The options of each flag are compiled and run by the testdata packages of the end-to-end test,
+// so the cases cover the combinations of flags and targets the testdata does not, such as generic or
+// imported target types.
+type renderTest struct {
+	name    string
+	tmpl    string
+	render  interface{ Complete() }
+	want    []string
+	notWant []string
+}
+
+func testRender(t *testing.T, tests []renderTest) {
+	t.Helper()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := tt.tmpl
+			if tmpl == "" {
+				tmpl = tmplOption
+			}
+			tt.render.Complete()
+			src, _, ok := renderSource(t, tmpl, tt.render)
+			if !ok {
+				return
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(src, want) {
+					t.Errorf("missing %q in\n%s", want, src)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(src, notWant) {
+					t.Errorf("got %q, want none in\n%s", notWant, src)
+				}
+			}
+		})
+	}
+}
+
+// renderSource returns the Go source rendered by tmpl with render, and its syntax tree,
+// or false if it does not parse.
+func renderSource(t *testing.T, tmpl string, render any) (string, *ast.File, bool) {
+	t.Helper()
+	var g Generator
+	g.Render(tmpl, render)
+	src := g.buf.String()
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, parser.ParseComments)
+	if err != nil {
+		t.Errorf("invalid Go generated: %s\n%s", err, src)
+		return src, nil, false
+	}
+	return src, f, true
+}
+
+func TestRenderDI(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "plain",
+			render: &TmplOptionRender{
+				PackageName:     "main",
+				TargetTypeName:  "Number",
+				TrimmedTypeName: "Number",
+				Fields:          []StructField{{FieldName: "Value", FieldType: "int"}},
+				DI:              true,
+			},
+			want: []string{
+				`import "go.uber.org/fx"`,
+				"func ProvideNumber(opts ...NumberOption) *Number {",
+				"return new(Number).ApplyOptions(opts...)",
+				`const NumberOptionGroup = "number_options"`,
+				"func NumberFxOption() fx.Option {",
+				`fx.Annotate(ProvideNumber, fx.ParamTags("group:\"number_options\""))`,
+				"func NumberOptionFxSupply(options ...NumberOption) fx.Option {",
+				// each constructor supplied returns its own option, even if loop variables are shared
+				"opt := opt",
+			},
+		},
+		{
+			name: "generic imported",
+			render: &TmplOptionRender{
+				PackageName:                  "main",
+				TargetTypeName:               "Pill",
+				TargetTypeImport:             "example.com/painkiller",
+				TargetTypeGenericDeclaration: "[T comparable]",
+				TargetTypeGenericParams:      "[T]",
+				TrimmedTypeName:              "Pill",
+				DI:                           true,
+			},
+			want: []string{
+				"func ProvidePill[T comparable](opts ...PillOption[T]) *Pill[T] {",
+				"return ApplyOptions(new(Pill[T]), opts...)",
+				"func PillFxOption[T comparable]() fx.Option {",
+				`fx.Annotate(ProvidePill[T], fx.ParamTags("group:\"pill_options\""))`,
+				"func PillOptionFxSupply[T comparable](options ...PillOption[T]) fx.Option {",
+			},
+		},
+	})
+}
+
+func TestRenderValidate(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "locked named",
+			render: &TmplOptionRender{
+				PackageName:        "main",
+				TargetTypeName:     "Number",
+				TrimmedTypeName:    "Number",
+				Fields:             []StructField{{FieldName: "Value", FieldType: "int"}},
+				LockFieldName:      "mu",
+				SnapshotFieldNames: []string{"Value"},
+				Named:              true,
+				Validate:           true,
+			},
+			want: []string{
+				"func (o *Number) ApplyOptionsReturnError(options ...NumberOption) error {",
+				"o.mu.Lock()",
+				"if err := opt.applyCheck(o); err != nil {",
+				"if hook := NumberOptionAppliedHook; hook != nil {",
+			},
+		},
+		{
+			name: "generic imported",
+			render: &TmplOptionRender{
+				PackageName:                  "main",
+				TargetTypeName:               "Pill",
+				TargetTypeImport:             "example.com/painkiller",
+				TargetTypeGenericDeclaration: "[T comparable]",
+				TargetTypeGenericParams:      "[T]",
+				TrimmedTypeName:              "Pill",
+				Validate:                     true,
+			},
+			want: []string{
+				"type PillOptionCheckFunc[T comparable] func(*Pill[T]) error",
+				"func (f PillOptionCheckFunc[T]) applyCheck(do *Pill[T]) error {",
+				"func ApplyOptionsReturnError[T comparable](o *Pill[T], options ...PillOption[T]) error {",
+			},
+		},
+	})
+}
+
+func TestRenderFixedArity(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "locked named",
+			render: &TmplOptionRender{
+				PackageName:        "main",
+				TargetTypeName:     "Number",
+				TrimmedTypeName:    "Number",
+				Fields:             []StructField{{FieldName: "Value", FieldType: "int"}},
+				LockFieldName:      "mu",
+				SnapshotFieldNames: []string{"Value"},
+				Named:              true,
+				FixedArity:         2,
+			},
+			want: []string{
+				"func (o *Number) ApplyOptions2(opt1, opt2 NumberOption) *Number {",
+				"o.mu.Lock()",
+				"if opt, ok := opt2.(interface{ Name() string }); ok {",
+			},
+			notWant: []string{"ApplyOptions3"},
+		},
+		{
+			name: "generic imported",
+			render: &TmplOptionRender{
+				PackageName:                  "main",
+				TargetTypeName:               "Pill",
+				TargetTypeImport:             "example.com/painkiller",
+				TargetTypeGenericDeclaration: "[T comparable]",
+				TargetTypeGenericParams:      "[T]",
+				TrimmedTypeName:              "Pill",
+				FixedArity:                   1,
+			},
+			want:    []string{"func ApplyOptions1[T comparable](o *Pill[T], opt1 PillOption[T]) *Pill[T] {"},
+			notWant: []string{"ApplyOptions2"},
+		},
+	})
+}
+
+func TestRenderPrefix(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "prefix naming the option interface",
+			render: &TmplOptionRender{
+				PackageName:     "main",
+				TargetTypeName:  "HashRing",
+				TrimmedTypeName: "HashRing",
+				Prefix:          "WithRing",
+				Fields:          []StructField{{FieldName: "NumReps", FieldType: "int"}},
+			},
+			want: []string{
+				"type RingOption interface {",
+				"func WithRingNumReps(v int) RingOption {",
+			},
+			notWant: []string{"HashRingOption", "WithHashRingNumReps"},
+		},
+	})
+}
+
+func TestRenderBuilder(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "generic imported prefixed",
+			render: &TmplOptionRender{
+				PackageName:                  "main",
+				TargetTypeName:               "Pill",
+				TargetTypeImport:             "example.com/painkiller",
+				TargetTypeGenericDeclaration: "[T comparable]",
+				TargetTypeGenericParams:      "[T]",
+				TrimmedTypeName:              "Pill",
+				Prefix:                       "Set",
+				Fields:                       []StructField{{FieldName: "Dose", FieldType: "T"}},
+				Builder:                      true,
+			},
+			want: []string{
+				"type PillBuilder[T comparable] struct {",
+				"func NewPillBuilder[T comparable]() *PillBuilder[T] {",
+				"return ApplyOptions(new(Pill[T]), b.options...)",
+				"func (b *PillBuilder[T]) SetDose(v T) *PillBuilder[T] {",
+				"return b.With(SetDose[T](v))",
+			},
+		},
+	})
+}
+
+func TestRenderDurationHelpers(t *testing.T) {
+	fields := []StructField{
+		{FieldName: "Timeout", FieldType: "time.Duration", DurationPackage: "time"},
+		{FieldName: "interval", FieldType: "time_.Duration", DurationPackage: "time_"},
+		{FieldName: "Name", FieldType: "string"},
+	}
+	testRender(t, []renderTest{
+		{
+			name: "time imported by another name",
+			render: &TmplOptionRender{
+				PackageName:     "main",
+				TargetTypeName:  "Client",
+				TrimmedTypeName: "Client",
+				Fields:          fields,
+				DurationHelpers: true,
+			},
+			want: []string{
+				"func WithClientIntervalSeconds(n int) ClientOption {",
+				"return WithClientInterval(time_.Duration(n) * time_.Second)",
+			},
+			notWant: []string{"WithClientNameSeconds", "WithClientNameMillis"},
+		},
+		{
+			name: "generic prefixed",
+			render: &TmplOptionRender{
+				PackageName:                  "main",
+				TargetTypeName:               "Client",
+				TargetTypeGenericDeclaration: "[T any]",
+				TargetTypeGenericParams:      "[T]",
+				TrimmedTypeName:              "Client",
+				Prefix:                       "Set",
+				Fields:                       fields,
+				DurationHelpers:              true,
+			},
+			want: []string{
+				"func SetTimeoutSeconds[T any](n int) ClientOption[T] {",
+				"return SetTimeout[T](time.Duration(n) * time.Second)",
+			},
+		},
+		{
+			name: "disabled",
+			render: &TmplOptionRender{
+				PackageName:     "main",
+				TargetTypeName:  "Client",
+				TrimmedTypeName: "Client",
+				Fields:          fields,
+			},
+			notWant: []string{"Seconds", "Millis"},
+		},
+	})
+}
+
+func TestRenderGetters(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "locked",
+			render: &TmplOptionRender{
+				PackageName:     "main",
+				TargetTypeName:  "Server",
+				TrimmedTypeName: "Server",
+				LockFieldName:   "mu",
+				Fields:          []StructField{{FieldName: "Addr", FieldType: "string"}},
+				Getters:         true,
+			},
+			want: []string{
+				"func (o *Server) GetAddr() string {\n\to.mu.Lock()\n\tdefer o.mu.Unlock()\n\treturn o.Addr",
+			},
+		},
+		{
+			name: "generic",
+			render: &TmplOptionRender{
+				PackageName:                  "main",
+				TargetTypeName:               "Server",
+				TargetTypeGenericDeclaration: "[T any]",
+				TargetTypeGenericParams:      "[T]",
+				TrimmedTypeName:              "Server",
+				Fields:                       []StructField{{FieldName: "Value", FieldType: "T"}},
+				Getters:                      true,
+			},
+			want: []string{"func (o *Server[T]) GetValue() T {"},
+		},
+		{
+			// no methods can be declared on a type of another package
+			name: "imported",
+			render: &TmplOptionRender{
+				PackageName:      "main",
+				TargetTypeName:   "Server",
+				TargetTypeImport: "example.com/server",
+				TrimmedTypeName:  "Server",
+				Fields:           []StructField{{FieldName: "Addr", FieldType: "string"}},
+				Getters:          true,
+			},
+			notWant: []string{"GetAddr"},
+		},
+		{
+			name: "disabled",
+			render: &TmplOptionRender{
+				PackageName:     "main",
+				TargetTypeName:  "Server",
+				TrimmedTypeName: "Server",
+				Fields:          []StructField{{FieldName: "Addr", FieldType: "string"}},
+			},
+			notWant: []string{"GetAddr"},
+		},
+	})
+}
+
+func TestRenderGenTest(t *testing.T) {
+	testRender(t, []renderTest{
+		{
+			name: "imported prefixed",
+			tmpl: tmplOptionTest,
+			render: &TmplOptionRender{
+				PackageName:      "main",
+				TargetTypeName:   "Server",
+				TargetTypeImport: "example.com/server",
+				TrimmedTypeName:  "Server",
+				Prefix:           "Set",
+				Fields:           []StructField{{FieldName: "Timeout", FieldType: "time.Duration", SampleValue: "5 * time.Second"}},
+			},
+			want: []string{
+				"var want time.Duration = 5 * time.Second",
+				"ApplyOptions(&o, SetTimeout(want))",
+				"ApplyOptions(&got, nil)",
+			},
+		},
+	})
+}
+
+func TestRenderBuildConstraints(t *testing.T) {
+	constraints := []string{"//go:build linux && !race", "// +build linux,!race"}
+	render := &TmplOptionRender{
+		PackageName:      "main",
+		TargetTypeName:   "Server",
+		TrimmedTypeName:  "Server",
+		Fields:           []StructField{{FieldName: "Addr", FieldType: "string", SampleValue: `"Addr"`}},
+		BuildConstraints: constraints,
+	}
+	render.Complete()
+	config := &TmplConfigRender{
+		PackageName:      "main",
+		TargetTypeName:   "Server",
+		TrimmedTypeName:  "Server",
+		BuildConstraints: constraints,
+	}
+	config.Complete()
+	for _, tt := range []struct {
+		name   string
+		tmpl   string
+		render any
+	}{
+		{name: "option", tmpl: tmplOption, render: render},
+		{name: "test", tmpl: tmplOptionTest, render: render},
+		{name: "config", tmpl: tmplConfig, render: config},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			src, f, ok := renderSource(t, tt.tmpl, tt.render)
+			if !ok {
+				return
+			}
+			if got := fileBuildConstraints(f); !slices.Equal(got, constraints) {
+				t.Errorf("got %q, want %q in\n%s", got, constraints, src)
+			}
+			// consecutive, and separated by a blank line from the package clause
+			if want := strings.Join(constraints, "\n") + "\n\n"; !strings.Contains(src, want) {
+				t.Errorf("missing %q in\n%s", want, src)
+			}
+		})
+	}
+}
+
+func TestCheckNames(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		render  *TmplOptionRender
+		wantErr bool
+	}{
+		{
+			name: "field named Defaults",
+			render: &TmplOptionRender{TargetTypeName: "Server", TrimmedTypeName: "Server",
+				Fields: []StructField{{FieldName: "Defaults", FieldType: "map[string]string"}}},
+			wantErr: true,
+		},
+		{
+			name: "field named Defaults, renamed by the option tag",
+			render: &TmplOptionRender{TargetTypeName: "Server", TrimmedTypeName: "Server",
+				Fields: []StructField{{FieldName: "Defaults", FieldType: "map[string]string",
+					OptionTag: reflect_.SubStructTag{Name: "DefaultValues"}}}},
+		},
+		{
+			name: "field named Defaults, with no type name in its option",
+			render: &TmplOptionRender{TargetTypeName: "Server", TrimmedTypeName: "Server",
+				Fields: []StructField{{FieldName: "Defaults", FieldType: "map[string]string",
+					OptionTag: reflect_.SubStructTag{Options: []string{TagOptionFlagShort}}}}},
+		},
+		{
+			name: "field named Defaults, locked with no With<Type>Defaults",
+			render: &TmplOptionRender{TargetTypeName: "Server", TrimmedTypeName: "Server", LockFieldName: "mu",
+				Fields: []StructField{{FieldName: "Defaults", FieldType: "map[string]string"}}},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.render.Complete()
+			if err := tt.render.checkNames(); (err != nil) != tt.wantErr {
+				t.Errorf("checkNames() = %v, want error %t", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fields set by the chained calls of a builder instead of options.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+//go:generate go-option -type "Builder" -builder
+type Builder struct {
+	Name    string
+	Timeout time.Duration // +optional-default:5s
+	Limit   *int
+	tags    []string
+	labels  map[string]string
+}
+
+func main() {
+	b := NewBuilderBuilder().
+		WithName("Name").
+		WithTimeoutDefault().
+		WithLimitValue(3).
+		WithTags("a", "b").
+		WithTagsReplace("c").
+		WithLabels(map[string]string{"k": "v"}).
+		With(WithBuilderName("Renamed"))
+	v := b.Build()
+	if v.Name != "Renamed" || v.Timeout != 5*time.Second || v.Limit == nil || *v.Limit != 3 ||
+		len(v.tags) != 1 || v.tags[0] != "c" || v.labels["k"] != "v" {
+		panic(fmt.Sprintf("Builder.go: %+v", v))
+	}
+	if w := b.Build(); w == v || w.Limit == v.Limit {
+		panic(fmt.Sprintf("Builder.go: Build returned the same Builder: %+v", w))
+	}
+}
@@ -0,0 +1,203 @@
+// Code generated by "go-option -type Builder -builder"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A BuilderOption sets options.
+type BuilderOption interface {
+	apply(*Builder)
+}
+
+// EmptyBuilderOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyBuilderOption struct{}
+
+func (EmptyBuilderOption) apply(*Builder) {}
+
+// BuilderOptionFunc wraps a function that modifies Builder into an
+// implementation of the BuilderOption interface.
+type BuilderOptionFunc func(*Builder)
+
+func (f BuilderOptionFunc) apply(do *Builder) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Builder) ApplyOptions(options ...BuilderOption) *Builder {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithBuilder sets Builder.
+func WithBuilder(v Builder) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		*o = v
+	})
+}
+
+// _default_Builder_value is the default of Builder, the zero value but for the fields with a default.
+var _default_Builder_value = func() (val Builder) {
+	val.Timeout = _default_Builder_Timeout_value
+	return
+}()
+
+// WithBuilderDefaults resets Builder to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithBuilderDefaults(), finalOverrides...).
+func WithBuilderDefaults() BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		*o = _default_Builder_value
+	})
+}
+
+// WithBuilderName sets Name in Builder.
+func WithBuilderName(v string) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.Name = v
+	})
+}
+
+// WithBuilderTimeout sets Timeout in Builder.
+func WithBuilderTimeout(v time.Duration) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.Timeout = v
+	})
+}
+
+// _default_Builder_Timeout_value is the default of Timeout in Builder, from "+optional-default:5s".
+var _default_Builder_Timeout_value time.Duration = 5 * time.Second
+
+// WithBuilderTimeoutDefault sets Timeout in Builder to its default 5s.
+func WithBuilderTimeoutDefault() BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.Timeout = _default_Builder_Timeout_value
+	})
+}
+
+// WithBuilderLimit sets Limit in Builder.
+func WithBuilderLimit(v *int) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.Limit = v
+	})
+}
+
+// WithBuilderLimitValue sets Limit in Builder to a pointer to a copy of v.
+func WithBuilderLimitValue(v int) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Limit = &v
+	})
+}
+
+// WithBuilderTags appends tags in Builder.
+func WithBuilderTags(v ...string) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.tags = append(o.tags, v...)
+	})
+}
+
+// WithBuilderTagsReplace sets tags in Builder.
+func WithBuilderTagsReplace(v ...string) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.tags = v
+	})
+}
+
+// WithBuilderLabels appends labels in Builder.
+func WithBuilderLabels(m map[string]string) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		if o.labels == nil {
+			o.labels = m
+			return
+		}
+		for k, v := range m {
+			o.labels[k] = v
+		}
+	})
+}
+
+// WithBuilderLabelsReplace sets labels in Builder.
+func WithBuilderLabelsReplace(v map[string]string) BuilderOption {
+	return BuilderOptionFunc(func(o *Builder) {
+		o.labels = v
+	})
+}
+
+// BuilderBuilder builds a Builder by chained calls, as an alternative to ApplyOptions:
+// its methods collect the options setting the fields, applied in order by Build.
+type BuilderBuilder struct {
+	options []BuilderOption
+}
+
+// NewBuilderBuilder returns an empty BuilderBuilder.
+func NewBuilderBuilder() *BuilderBuilder {
+	return &BuilderBuilder{}
+}
+
+// With appends options to b, such as options written by hand, and returns b.
+func (b *BuilderBuilder) With(options ...BuilderOption) *BuilderBuilder {
+	b.options = append(b.options, options...)
+	return b
+}
+
+// Build returns a new Builder with the options of b applied in order.
+// Build returns another Builder each time it is called, though the slices and maps set by
+// the options of b are shared, as when the same options are applied twice.
+func (b *BuilderBuilder) Build() *Builder {
+	return new(Builder).ApplyOptions(b.options...)
+}
+
+// WithName sets Name, as WithBuilderName does, and returns b.
+func (b *BuilderBuilder) WithName(v string) *BuilderBuilder {
+	return b.With(WithBuilderName(v))
+}
+
+// WithTimeout sets Timeout, as WithBuilderTimeout does, and returns b.
+func (b *BuilderBuilder) WithTimeout(v time.Duration) *BuilderBuilder {
+	return b.With(WithBuilderTimeout(v))
+}
+
+// WithTimeoutDefault sets Timeout to its default, as WithBuilderTimeoutDefault does, and returns b.
+func (b *BuilderBuilder) WithTimeoutDefault() *BuilderBuilder {
+	return b.With(WithBuilderTimeoutDefault())
+}
+
+// WithLimit sets Limit, as WithBuilderLimit does, and returns b.
+func (b *BuilderBuilder) WithLimit(v *int) *BuilderBuilder {
+	return b.With(WithBuilderLimit(v))
+}
+
+// WithLimitValue sets Limit to a pointer to a copy of v, as WithBuilderLimitValue does, and returns b.
+func (b *BuilderBuilder) WithLimitValue(v int) *BuilderBuilder {
+	return b.With(WithBuilderLimitValue(v))
+}
+
+// WithTags appends tags, as WithBuilderTags does, and returns b.
+func (b *BuilderBuilder) WithTags(v ...string) *BuilderBuilder {
+	return b.With(WithBuilderTags(v...))
+}
+
+// WithTagsReplace sets tags, as WithBuilderTagsReplace does, and returns b.
+func (b *BuilderBuilder) WithTagsReplace(v ...string) *BuilderBuilder {
+	return b.With(WithBuilderTagsReplace(v...))
+}
+
+// WithLabels appends labels, as WithBuilderLabels does, and returns b.
+func (b *BuilderBuilder) WithLabels(m map[string]string) *BuilderBuilder {
+	return b.With(WithBuilderLabels(m))
+}
+
+// WithLabelsReplace sets labels, as WithBuilderLabelsReplace does, and returns b.
+func (b *BuilderBuilder) WithLabelsReplace(v map[string]string) *BuilderBuilder {
+	return b.With(WithBuilderLabelsReplace(v))
+}
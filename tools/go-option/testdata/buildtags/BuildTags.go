@@ -0,0 +1,25 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.18 && !go_option_never
+// +build go1.18,!go_option_never
+
+// Options generated under the build constraints of the file declaring the type.
+
+package main
+
+import "fmt"
+
+//go:generate go-option -type "BuildTags"
+type BuildTags struct {
+	Name string
+}
+
+func main() {
+	var b BuildTags
+	b.ApplyOptions(WithBuildTagsName("Name"))
+	if b.Name != "Name" {
+		panic(fmt.Sprintf("BuildTags.go: Name: got %q, want %q", b.Name, "Name"))
+	}
+}
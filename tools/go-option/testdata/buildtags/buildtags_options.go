@@ -0,0 +1,68 @@
+// Code generated by "go-option -type BuildTags"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+//go:build go1.18 && !go_option_never
+// +build go1.18,!go_option_never
+
+package main
+
+// A BuildTagsOption sets options.
+type BuildTagsOption interface {
+	apply(*BuildTags)
+}
+
+// EmptyBuildTagsOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyBuildTagsOption struct{}
+
+func (EmptyBuildTagsOption) apply(*BuildTags) {}
+
+// BuildTagsOptionFunc wraps a function that modifies BuildTags into an
+// implementation of the BuildTagsOption interface.
+type BuildTagsOptionFunc func(*BuildTags)
+
+func (f BuildTagsOptionFunc) apply(do *BuildTags) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *BuildTags) ApplyOptions(options ...BuildTagsOption) *BuildTags {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithBuildTags sets BuildTags.
+func WithBuildTags(v BuildTags) BuildTagsOption {
+	return BuildTagsOptionFunc(func(o *BuildTags) {
+		*o = v
+	})
+}
+
+// _default_BuildTags_value is the default of BuildTags, the zero value but for the fields with a default.
+var _default_BuildTags_value = func() (val BuildTags) {
+	return
+}()
+
+// WithBuildTagsDefaults resets BuildTags to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithBuildTagsDefaults(), finalOverrides...).
+func WithBuildTagsDefaults() BuildTagsOption {
+	return BuildTagsOptionFunc(func(o *BuildTags) {
+		*o = _default_BuildTags_value
+	})
+}
+
+// WithBuildTagsName sets Name in BuildTags.
+func WithBuildTagsName(v string) BuildTagsOption {
+	return BuildTagsOptionFunc(func(o *BuildTags) {
+		o.Name = v
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options with defaults set by the +optional-default directive or the default tag.
+
+package main
+
+import (
+	"fmt"
+	"time"
+	time_ "time"
+)
+
+//go:generate go-option -type "Defaults"
+type Defaults struct {
+	// This is Timeout doc comment
+	// +optional-default:5s
+	Timeout  time.Duration
+	Interval time_.Duration // +optional-default:1m30s
+	Jitter   time.Duration  // +optional-default:1500us
+	Name     string         // +optional-default:"default name"
+	Retries  int8           // +optional-default:-3
+	Mask     uint32         // +optional-default:0xff
+	Ratio    float32        // +optional-default:0.1
+	Enabled  bool           // +optional-default:true
+	Address  string
+	Backoff  time.Duration `default:"250ms"`
+	Hosts    string        `json:"hosts" default:"localhost,127.0.0.1"`
+	Port     uint16        `default:"8080"`
+	Verbose  bool          `default:"true"`
+}
+
+func NewDefaults(opts ...DefaultsOption) *Defaults {
+	return (&Defaults{}).ApplyOptions(opts...)
+}
+
+func main() {
+	d := NewDefaults(WithDefaultsTimeoutDefault(), WithDefaultsIntervalDefault(), WithDefaultsJitterDefault(),
+		WithDefaultsNameDefault(), WithDefaultsRetriesDefault(), WithDefaultsMaskDefault(),
+		WithDefaultsRatioDefault(), WithDefaultsEnabledDefault(), WithDefaultsAddress("Address"),
+		WithDefaultsBackoffDefault(), WithDefaultsHostsDefault(), WithDefaultsPortDefault(), WithDefaultsVerboseDefault())
+	want := Defaults{
+		Timeout:  5 * time.Second,
+		Interval: 90 * time.Second,
+		Jitter:   1500 * time.Microsecond,
+		Name:     "default name",
+		Retries:  -3,
+		Mask:     255,
+		Ratio:    0.1,
+		Enabled:  true,
+		Address:  "Address",
+		Backoff:  250 * time.Millisecond,
+		Hosts:    "localhost,127.0.0.1",
+		Port:     8080,
+		Verbose:  true,
+	}
+	if *d != want {
+		panic(fmt.Sprintf("Defaults.go: got %+v, want %+v", *d, want))
+	}
+
+	// a later option overrides the default
+	d = NewDefaults(WithDefaultsTimeoutDefault(), WithDefaultsTimeout(time.Minute))
+	if d.Timeout != time.Minute {
+		panic(fmt.Sprintf("Defaults.go: Timeout: got %s, want %s", d.Timeout, time.Minute))
+	}
+
+	// WithDefaultsDefaults discards the options before it, but not the ones after it
+	d = NewDefaults(WithDefaultsAddress("Address"), WithDefaultsTimeout(time.Minute),
+		WithDefaultsDefaults(), WithDefaultsName("Name"))
+	want.Address = ""
+	want.Name = "Name"
+	if *d != want {
+		panic(fmt.Sprintf("Defaults.go: WithDefaultsDefaults: got %+v, want %+v", *d, want))
+	}
+}
@@ -0,0 +1,36 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// A field named Defaults, its option renamed by the option tag not to collide with WithServerDefaults.
+
+package main
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+//go:generate go-option -type "Server"
+type Server struct {
+	Defaults map[string]string `option:"DefaultValues"`
+	Timeout  time.Duration     // +optional-default:5s
+}
+
+func NewServer(opts ...ServerOption) *Server {
+	return (&Server{}).ApplyOptions(opts...)
+}
+
+func main() {
+	s := NewServer(WithServerDefaultValues(map[string]string{"k": "v"}), WithServerTimeout(time.Minute))
+	if want := map[string]string{"k": "v"}; !maps.Equal(s.Defaults, want) || s.Timeout != time.Minute {
+		panic(fmt.Sprintf("Server.go: got %+v, want {Defaults: %v Timeout: %s}", *s, want, time.Minute))
+	}
+
+	// WithServerDefaults resets the whole type, the field included
+	s = NewServer(WithServerDefaultValues(map[string]string{"k": "v"}), WithServerDefaults())
+	if s.Defaults != nil || s.Timeout != 5*time.Second {
+		panic(fmt.Sprintf("Server.go: WithServerDefaults: got %+v, want {Defaults: map[] Timeout: %s}", *s, 5*time.Second))
+	}
+}
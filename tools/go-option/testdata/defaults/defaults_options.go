@@ -0,0 +1,288 @@
+// Code generated by "go-option -type Defaults"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import (
+	"time"
+
+	time_ "time"
+)
+
+// A DefaultsOption sets options.
+type DefaultsOption interface {
+	apply(*Defaults)
+}
+
+// EmptyDefaultsOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyDefaultsOption struct{}
+
+func (EmptyDefaultsOption) apply(*Defaults) {}
+
+// DefaultsOptionFunc wraps a function that modifies Defaults into an
+// implementation of the DefaultsOption interface.
+type DefaultsOptionFunc func(*Defaults)
+
+func (f DefaultsOptionFunc) apply(do *Defaults) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Defaults) ApplyOptions(options ...DefaultsOption) *Defaults {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithDefaults sets Defaults.
+func WithDefaults(v Defaults) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		*o = v
+	})
+}
+
+// _default_Defaults_value is the default of Defaults, the zero value but for the fields with a default.
+var _default_Defaults_value = func() (val Defaults) {
+	val.Timeout = _default_Defaults_Timeout_value
+	val.Interval = _default_Defaults_Interval_value
+	val.Jitter = _default_Defaults_Jitter_value
+	val.Name = _default_Defaults_Name_value
+	val.Retries = _default_Defaults_Retries_value
+	val.Mask = _default_Defaults_Mask_value
+	val.Ratio = _default_Defaults_Ratio_value
+	val.Enabled = _default_Defaults_Enabled_value
+	val.Backoff = _default_Defaults_Backoff_value
+	val.Hosts = _default_Defaults_Hosts_value
+	val.Port = _default_Defaults_Port_value
+	val.Verbose = _default_Defaults_Verbose_value
+	return
+}()
+
+// WithDefaultsDefaults resets Defaults to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithDefaultsDefaults(), finalOverrides...).
+func WithDefaultsDefaults() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		*o = _default_Defaults_value
+	})
+}
+
+// WithDefaultsTimeout sets Timeout in Defaults.
+// This is Timeout doc comment
+func WithDefaultsTimeout(v time.Duration) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Timeout = v
+	})
+}
+
+// _default_Defaults_Timeout_value is the default of Timeout in Defaults, from "+optional-default:5s".
+var _default_Defaults_Timeout_value time.Duration = 5 * time.Second
+
+// WithDefaultsTimeoutDefault sets Timeout in Defaults to its default 5s.
+func WithDefaultsTimeoutDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Timeout = _default_Defaults_Timeout_value
+	})
+}
+
+// WithDefaultsInterval sets Interval in Defaults.
+func WithDefaultsInterval(v time_.Duration) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Interval = v
+	})
+}
+
+// _default_Defaults_Interval_value is the default of Interval in Defaults, from "+optional-default:1m30s".
+var _default_Defaults_Interval_value time_.Duration = 90 * time_.Second
+
+// WithDefaultsIntervalDefault sets Interval in Defaults to its default 1m30s.
+func WithDefaultsIntervalDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Interval = _default_Defaults_Interval_value
+	})
+}
+
+// WithDefaultsJitter sets Jitter in Defaults.
+func WithDefaultsJitter(v time.Duration) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Jitter = v
+	})
+}
+
+// _default_Defaults_Jitter_value is the default of Jitter in Defaults, from "+optional-default:1500us".
+var _default_Defaults_Jitter_value time.Duration = 1500 * time.Microsecond
+
+// WithDefaultsJitterDefault sets Jitter in Defaults to its default 1500us.
+func WithDefaultsJitterDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Jitter = _default_Defaults_Jitter_value
+	})
+}
+
+// WithDefaultsName sets Name in Defaults.
+func WithDefaultsName(v string) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Name = v
+	})
+}
+
+// _default_Defaults_Name_value is the default of Name in Defaults, from "+optional-default:"default name"".
+var _default_Defaults_Name_value string = "default name"
+
+// WithDefaultsNameDefault sets Name in Defaults to its default "default name".
+func WithDefaultsNameDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Name = _default_Defaults_Name_value
+	})
+}
+
+// WithDefaultsRetries sets Retries in Defaults.
+func WithDefaultsRetries(v int8) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Retries = v
+	})
+}
+
+// _default_Defaults_Retries_value is the default of Retries in Defaults, from "+optional-default:-3".
+var _default_Defaults_Retries_value int8 = -3
+
+// WithDefaultsRetriesDefault sets Retries in Defaults to its default -3.
+func WithDefaultsRetriesDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Retries = _default_Defaults_Retries_value
+	})
+}
+
+// WithDefaultsMask sets Mask in Defaults.
+func WithDefaultsMask(v uint32) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Mask = v
+	})
+}
+
+// _default_Defaults_Mask_value is the default of Mask in Defaults, from "+optional-default:0xff".
+var _default_Defaults_Mask_value uint32 = 255
+
+// WithDefaultsMaskDefault sets Mask in Defaults to its default 0xff.
+func WithDefaultsMaskDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Mask = _default_Defaults_Mask_value
+	})
+}
+
+// WithDefaultsRatio sets Ratio in Defaults.
+func WithDefaultsRatio(v float32) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Ratio = v
+	})
+}
+
+// _default_Defaults_Ratio_value is the default of Ratio in Defaults, from "+optional-default:0.1".
+var _default_Defaults_Ratio_value float32 = 0.1
+
+// WithDefaultsRatioDefault sets Ratio in Defaults to its default 0.1.
+func WithDefaultsRatioDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Ratio = _default_Defaults_Ratio_value
+	})
+}
+
+// WithDefaultsEnabled sets Enabled in Defaults.
+func WithDefaultsEnabled(v bool) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Enabled = v
+	})
+}
+
+// _default_Defaults_Enabled_value is the default of Enabled in Defaults, from "+optional-default:true".
+var _default_Defaults_Enabled_value bool = true
+
+// WithDefaultsEnabledDefault sets Enabled in Defaults to its default true.
+func WithDefaultsEnabledDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Enabled = _default_Defaults_Enabled_value
+	})
+}
+
+// WithDefaultsAddress sets Address in Defaults.
+func WithDefaultsAddress(v string) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Address = v
+	})
+}
+
+// WithDefaultsBackoff sets Backoff in Defaults.
+func WithDefaultsBackoff(v time.Duration) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Backoff = v
+	})
+}
+
+// _default_Defaults_Backoff_value is the default of Backoff in Defaults, from default:"250ms".
+var _default_Defaults_Backoff_value time.Duration = 250 * time.Millisecond
+
+// WithDefaultsBackoffDefault sets Backoff in Defaults to its default 250ms.
+func WithDefaultsBackoffDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Backoff = _default_Defaults_Backoff_value
+	})
+}
+
+// WithDefaultsHosts sets Hosts in Defaults.
+func WithDefaultsHosts(v string) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Hosts = v
+	})
+}
+
+// _default_Defaults_Hosts_value is the default of Hosts in Defaults, from default:"localhost,127.0.0.1".
+var _default_Defaults_Hosts_value string = "localhost,127.0.0.1"
+
+// WithDefaultsHostsDefault sets Hosts in Defaults to its default localhost,127.0.0.1.
+func WithDefaultsHostsDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Hosts = _default_Defaults_Hosts_value
+	})
+}
+
+// WithDefaultsPort sets Port in Defaults.
+func WithDefaultsPort(v uint16) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Port = v
+	})
+}
+
+// _default_Defaults_Port_value is the default of Port in Defaults, from default:"8080".
+var _default_Defaults_Port_value uint16 = 8080
+
+// WithDefaultsPortDefault sets Port in Defaults to its default 8080.
+func WithDefaultsPortDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Port = _default_Defaults_Port_value
+	})
+}
+
+// WithDefaultsVerbose sets Verbose in Defaults.
+func WithDefaultsVerbose(v bool) DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Verbose = v
+	})
+}
+
+// _default_Defaults_Verbose_value is the default of Verbose in Defaults, from default:"true".
+var _default_Defaults_Verbose_value bool = true
+
+// WithDefaultsVerboseDefault sets Verbose in Defaults to its default true.
+func WithDefaultsVerboseDefault() DefaultsOption {
+	return DefaultsOptionFunc(func(o *Defaults) {
+		o.Verbose = _default_Defaults_Verbose_value
+	})
+}
@@ -0,0 +1,98 @@
+// Code generated by "go-option -type Server"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A ServerOption sets options.
+type ServerOption interface {
+	apply(*Server)
+}
+
+// EmptyServerOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyServerOption struct{}
+
+func (EmptyServerOption) apply(*Server) {}
+
+// ServerOptionFunc wraps a function that modifies Server into an
+// implementation of the ServerOption interface.
+type ServerOptionFunc func(*Server)
+
+func (f ServerOptionFunc) apply(do *Server) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Server) ApplyOptions(options ...ServerOption) *Server {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithServer sets Server.
+func WithServer(v Server) ServerOption {
+	return ServerOptionFunc(func(o *Server) {
+		*o = v
+	})
+}
+
+// _default_Server_value is the default of Server, the zero value but for the fields with a default.
+var _default_Server_value = func() (val Server) {
+	val.Timeout = _default_Server_Timeout_value
+	return
+}()
+
+// WithServerDefaults resets Server to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithServerDefaults(), finalOverrides...).
+func WithServerDefaults() ServerOption {
+	return ServerOptionFunc(func(o *Server) {
+		*o = _default_Server_value
+	})
+}
+
+// WithServerDefaultValues appends Defaults in Server.
+func WithServerDefaultValues(m map[string]string) ServerOption {
+	return ServerOptionFunc(func(o *Server) {
+		if o.Defaults == nil {
+			o.Defaults = m
+			return
+		}
+		for k, v := range m {
+			o.Defaults[k] = v
+		}
+	})
+}
+
+// WithServerDefaultValuesReplace sets Defaults in Server.
+func WithServerDefaultValuesReplace(v map[string]string) ServerOption {
+	return ServerOptionFunc(func(o *Server) {
+		o.Defaults = v
+	})
+}
+
+// WithServerTimeout sets Timeout in Server.
+func WithServerTimeout(v time.Duration) ServerOption {
+	return ServerOptionFunc(func(o *Server) {
+		o.Timeout = v
+	})
+}
+
+// _default_Server_Timeout_value is the default of Timeout in Server, from "+optional-default:5s".
+var _default_Server_Timeout_value time.Duration = 5 * time.Second
+
+// WithServerTimeoutDefault sets Timeout in Server to its default 5s.
+func WithServerTimeoutDefault() ServerOption {
+	return ServerOptionFunc(func(o *Server) {
+		o.Timeout = _default_Server_Timeout_value
+	})
+}
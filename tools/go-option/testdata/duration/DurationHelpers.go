@@ -0,0 +1,42 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Duration fields set by a number of seconds or milliseconds, without importing time.
+
+package main
+
+import (
+	"fmt"
+	"time"
+	time_ "time"
+)
+
+//go:generate go-option -type "DurationHelpers" -durationhelpers
+type DurationHelpers struct {
+	Timeout  time.Duration
+	Interval time_.Duration
+	Deadline *time.Duration
+	Name     string
+}
+
+func main() {
+	var d DurationHelpers
+	d.ApplyOptions(WithDurationHelpersTimeoutSeconds(3), WithDurationHelpersIntervalMillis(1500))
+	if d.Timeout != 3*time.Second {
+		panic(fmt.Sprintf("DurationHelpers.go: Timeout: got %s, want %s", d.Timeout, 3*time.Second))
+	}
+	if d.Interval != 1500*time.Millisecond {
+		panic(fmt.Sprintf("DurationHelpers.go: Interval: got %s, want %s", d.Interval, 1500*time.Millisecond))
+	}
+
+	// the helpers set the field as the option of the field does, a later option overriding them
+	d.ApplyOptions(WithDurationHelpersTimeoutMillis(250), WithDurationHelpersIntervalSeconds(2),
+		WithDurationHelpersInterval(time.Minute))
+	if d.Timeout != 250*time.Millisecond {
+		panic(fmt.Sprintf("DurationHelpers.go: Timeout: got %s, want %s", d.Timeout, 250*time.Millisecond))
+	}
+	if d.Interval != time.Minute {
+		panic(fmt.Sprintf("DurationHelpers.go: Interval: got %s, want %s", d.Interval, time.Minute))
+	}
+}
@@ -0,0 +1,120 @@
+// Code generated by "go-option -type DurationHelpers -durationhelpers"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import (
+	"time"
+
+	time_ "time"
+)
+
+// A DurationHelpersOption sets options.
+type DurationHelpersOption interface {
+	apply(*DurationHelpers)
+}
+
+// EmptyDurationHelpersOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyDurationHelpersOption struct{}
+
+func (EmptyDurationHelpersOption) apply(*DurationHelpers) {}
+
+// DurationHelpersOptionFunc wraps a function that modifies DurationHelpers into an
+// implementation of the DurationHelpersOption interface.
+type DurationHelpersOptionFunc func(*DurationHelpers)
+
+func (f DurationHelpersOptionFunc) apply(do *DurationHelpers) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *DurationHelpers) ApplyOptions(options ...DurationHelpersOption) *DurationHelpers {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithDurationHelpers sets DurationHelpers.
+func WithDurationHelpers(v DurationHelpers) DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		*o = v
+	})
+}
+
+// _default_DurationHelpers_value is the default of DurationHelpers, the zero value but for the fields with a default.
+var _default_DurationHelpers_value = func() (val DurationHelpers) {
+	return
+}()
+
+// WithDurationHelpersDefaults resets DurationHelpers to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithDurationHelpersDefaults(), finalOverrides...).
+func WithDurationHelpersDefaults() DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		*o = _default_DurationHelpers_value
+	})
+}
+
+// WithDurationHelpersTimeout sets Timeout in DurationHelpers.
+func WithDurationHelpersTimeout(v time.Duration) DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		o.Timeout = v
+	})
+}
+
+// WithDurationHelpersTimeoutSeconds sets Timeout in DurationHelpers to n seconds.
+func WithDurationHelpersTimeoutSeconds(n int) DurationHelpersOption {
+	return WithDurationHelpersTimeout(time.Duration(n) * time.Second)
+}
+
+// WithDurationHelpersTimeoutMillis sets Timeout in DurationHelpers to n milliseconds.
+func WithDurationHelpersTimeoutMillis(n int) DurationHelpersOption {
+	return WithDurationHelpersTimeout(time.Duration(n) * time.Millisecond)
+}
+
+// WithDurationHelpersInterval sets Interval in DurationHelpers.
+func WithDurationHelpersInterval(v time_.Duration) DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		o.Interval = v
+	})
+}
+
+// WithDurationHelpersIntervalSeconds sets Interval in DurationHelpers to n seconds.
+func WithDurationHelpersIntervalSeconds(n int) DurationHelpersOption {
+	return WithDurationHelpersInterval(time_.Duration(n) * time_.Second)
+}
+
+// WithDurationHelpersIntervalMillis sets Interval in DurationHelpers to n milliseconds.
+func WithDurationHelpersIntervalMillis(n int) DurationHelpersOption {
+	return WithDurationHelpersInterval(time_.Duration(n) * time_.Millisecond)
+}
+
+// WithDurationHelpersDeadline sets Deadline in DurationHelpers.
+func WithDurationHelpersDeadline(v *time.Duration) DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		o.Deadline = v
+	})
+}
+
+// WithDurationHelpersDeadlineValue sets Deadline in DurationHelpers to a pointer to a copy of v.
+func WithDurationHelpersDeadlineValue(v time.Duration) DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Deadline = &v
+	})
+}
+
+// WithDurationHelpersName sets Name in DurationHelpers.
+func WithDurationHelpersName(v string) DurationHelpersOption {
+	return DurationHelpersOptionFunc(func(o *DurationHelpers) {
+		o.Name = v
+	})
+}
@@ -0,0 +1,49 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fields of a struct embedded along two paths, ambiguous and not promoted.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+)
+
+type Inner struct {
+	Deep int // ambiguous as AmbA.Inner.Deep and AmbB.Inner.Deep
+}
+
+type AmbA struct {
+	Inner
+	A int
+}
+
+type AmbB struct {
+	Inner
+	B int
+}
+
+//go:generate go-option -type "Amb"
+type Amb struct {
+	AmbA
+	AmbB
+}
+
+func main() {
+	var got Amb
+	got.ApplyOptions(WithAmbA(1), WithAmbB(2))
+	var want Amb
+	want.A = 1
+	want.B = 2
+	if !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("Amb.go: ApplyOptions(promoted): got %+v, want %+v", got, want))
+	}
+
+	// the embedded structs themselves are still set as a whole
+	got.ApplyOptions(WithAmbAmbA(AmbA{Inner: Inner{Deep: 3}}))
+	if got.AmbA.Deep != 3 || got.A != 0 {
+		panic(fmt.Sprintf("Amb.go: ApplyOptions(%s): got %+v", "AmbA", got))
+	}
+}
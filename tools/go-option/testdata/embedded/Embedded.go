@@ -0,0 +1,75 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options of the fields promoted from embedded structs.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+)
+
+type Base struct {
+	Timeout time.Duration
+	Tags    []string
+	Name    string // hidden by Embedded.Name
+	Shared  int    // ambiguous with Extra.Shared
+	Nested
+}
+
+type Extra struct {
+	Retries int
+	Shared  int
+	Ignored string `option:"-"`
+}
+
+type Nested struct {
+	Level   int
+	Retries int // hidden by Extra.Retries
+}
+
+type Skipped struct {
+	Hidden string
+}
+
+type Pointed struct {
+	Unsafe string
+}
+
+//go:generate go-option -type "Embedded"
+type Embedded struct {
+	Name string
+	Base
+	Extra
+	Skipped `option:"-"`
+	*Pointed
+}
+
+func main() {
+	var got Embedded
+	got.ApplyOptions(
+		WithEmbeddedName("name"),
+		WithEmbeddedTimeout(time.Second),
+		WithEmbeddedTags("a", "b"),
+		WithEmbeddedRetries(3),
+		WithEmbeddedLevel(2),
+	)
+	var want Embedded
+	want.Name = "name"
+	want.Timeout = time.Second
+	want.Tags = []string{"a", "b"}
+	want.Extra.Retries = 3
+	want.Level = 2
+	if !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("Embedded.go: ApplyOptions(promoted): got %+v, want %+v", got, want))
+	}
+
+	// the embedded structs themselves are still set as a whole
+	got.ApplyOptions(WithEmbeddedBase(Base{Shared: 1}))
+	if got.Base.Shared != 1 || got.Timeout != 0 {
+		panic(fmt.Sprintf("Embedded.go: ApplyOptions(%s): got %+v", "Base", got))
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by "go-option -type Amb"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A AmbOption sets options.
+type AmbOption interface {
+	apply(*Amb)
+}
+
+// EmptyAmbOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyAmbOption struct{}
+
+func (EmptyAmbOption) apply(*Amb) {}
+
+// AmbOptionFunc wraps a function that modifies Amb into an
+// implementation of the AmbOption interface.
+type AmbOptionFunc func(*Amb)
+
+func (f AmbOptionFunc) apply(do *Amb) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Amb) ApplyOptions(options ...AmbOption) *Amb {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithAmb sets Amb.
+func WithAmb(v Amb) AmbOption {
+	return AmbOptionFunc(func(o *Amb) {
+		*o = v
+	})
+}
+
+// _default_Amb_value is the default of Amb, the zero value but for the fields with a default.
+var _default_Amb_value = func() (val Amb) {
+	return
+}()
+
+// WithAmbDefaults resets Amb to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithAmbDefaults(), finalOverrides...).
+func WithAmbDefaults() AmbOption {
+	return AmbOptionFunc(func(o *Amb) {
+		*o = _default_Amb_value
+	})
+}
+
+// WithAmbAmbA sets AmbA in Amb.
+func WithAmbAmbA(v AmbA) AmbOption {
+	return AmbOptionFunc(func(o *Amb) {
+		o.AmbA = v
+	})
+}
+
+// WithAmbAmbB sets AmbB in Amb.
+func WithAmbAmbB(v AmbB) AmbOption {
+	return AmbOptionFunc(func(o *Amb) {
+		o.AmbB = v
+	})
+}
+
+// WithAmbA sets A in Amb.
+func WithAmbA(v int) AmbOption {
+	return AmbOptionFunc(func(o *Amb) {
+		o.A = v
+	})
+}
+
+// WithAmbB sets B in Amb.
+func WithAmbB(v int) AmbOption {
+	return AmbOptionFunc(func(o *Amb) {
+		o.B = v
+	})
+}
@@ -0,0 +1,123 @@
+// Code generated by "go-option -type Embedded"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A EmbeddedOption sets options.
+type EmbeddedOption interface {
+	apply(*Embedded)
+}
+
+// EmptyEmbeddedOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyEmbeddedOption struct{}
+
+func (EmptyEmbeddedOption) apply(*Embedded) {}
+
+// EmbeddedOptionFunc wraps a function that modifies Embedded into an
+// implementation of the EmbeddedOption interface.
+type EmbeddedOptionFunc func(*Embedded)
+
+func (f EmbeddedOptionFunc) apply(do *Embedded) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Embedded) ApplyOptions(options ...EmbeddedOption) *Embedded {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithEmbedded sets Embedded.
+func WithEmbedded(v Embedded) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		*o = v
+	})
+}
+
+// _default_Embedded_value is the default of Embedded, the zero value but for the fields with a default.
+var _default_Embedded_value = func() (val Embedded) {
+	return
+}()
+
+// WithEmbeddedDefaults resets Embedded to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithEmbeddedDefaults(), finalOverrides...).
+func WithEmbeddedDefaults() EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		*o = _default_Embedded_value
+	})
+}
+
+// WithEmbeddedName sets Name in Embedded.
+func WithEmbeddedName(v string) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Name = v
+	})
+}
+
+// WithEmbeddedBase sets Base in Embedded.
+func WithEmbeddedBase(v Base) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Base = v
+	})
+}
+
+// WithEmbeddedExtra sets Extra in Embedded.
+func WithEmbeddedExtra(v Extra) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Extra = v
+	})
+}
+
+// WithEmbeddedTimeout sets Timeout in Embedded.
+func WithEmbeddedTimeout(v time.Duration) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Timeout = v
+	})
+}
+
+// WithEmbeddedTags appends Tags in Embedded.
+func WithEmbeddedTags(v ...string) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Tags = append(o.Tags, v...)
+	})
+}
+
+// WithEmbeddedTagsReplace sets Tags in Embedded.
+func WithEmbeddedTagsReplace(v ...string) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Tags = v
+	})
+}
+
+// WithEmbeddedNested sets Nested in Embedded.
+func WithEmbeddedNested(v Nested) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Nested = v
+	})
+}
+
+// WithEmbeddedRetries sets Retries in Embedded.
+func WithEmbeddedRetries(v int) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Retries = v
+	})
+}
+
+// WithEmbeddedLevel sets Level in Embedded.
+func WithEmbeddedLevel(v int) EmbeddedOption {
+	return EmbeddedOptionFunc(func(o *Embedded) {
+		o.Level = v
+	})
+}
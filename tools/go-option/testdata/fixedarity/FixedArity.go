@@ -0,0 +1,43 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// A fixed number of options applied without the variadic slice of ApplyOptions.
+
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+//go:generate go-option -type "FixedArity" -fixed-arity=3
+type FixedArity struct {
+	Name string
+	Port int
+	Tags []string
+}
+
+// applied indirectly, as by a constructor taking the apply func, so that the variadic slice escapes
+var (
+	applyOptions  = (*FixedArity).ApplyOptions
+	applyOptions3 = (*FixedArity).ApplyOptions3
+)
+
+func main() {
+	name, port, tags := WithFixedArityName("name"), WithFixedArityPort(80), WithFixedArityTags("a")
+
+	var got FixedArity
+	got.ApplyOptions1(name).ApplyOptions2(nil, port).ApplyOptions3(tags, nil, tags)
+	want := FixedArity{Name: "name", Port: 80, Tags: []string{"a", "a"}}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		panic(fmt.Sprintf("FixedArity.go: ApplyOptions1-3: got %+v, want %+v", got, want))
+	}
+
+	// no allocation by the fixed form, applying options built ahead, unlike the variadic one;
+	// see BenchmarkApplyOptionsFixedArity for both
+	o := new(FixedArity)
+	if allocs := testing.AllocsPerRun(100, func() { applyOptions3(o, name, port, nil) }); allocs != 0 {
+		panic(fmt.Sprintf("FixedArity.go: allocations of ApplyOptions3: got %v, want 0", allocs))
+	}
+}
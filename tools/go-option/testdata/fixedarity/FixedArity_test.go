@@ -0,0 +1,26 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import "testing"
+
+// BenchmarkApplyOptionsFixedArity compares the allocations of the variadic form against the fixed one,
+// applying options built ahead.
+func BenchmarkApplyOptionsFixedArity(b *testing.B) {
+	name, port := WithFixedArityName("name"), WithFixedArityPort(80)
+	o := new(FixedArity)
+	b.Run("ApplyOptions", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			applyOptions(o, name, port, nil)
+		}
+	})
+	b.Run("ApplyOptions3", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			applyOptions3(o, name, port, nil)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+// Code generated by "go-option -type FixedArity -fixed-arity=3"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A FixedArityOption sets options.
+type FixedArityOption interface {
+	apply(*FixedArity)
+}
+
+// EmptyFixedArityOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyFixedArityOption struct{}
+
+func (EmptyFixedArityOption) apply(*FixedArity) {}
+
+// FixedArityOptionFunc wraps a function that modifies FixedArity into an
+// implementation of the FixedArityOption interface.
+type FixedArityOptionFunc func(*FixedArity)
+
+func (f FixedArityOptionFunc) apply(do *FixedArity) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *FixedArity) ApplyOptions(options ...FixedArityOption) *FixedArity {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// ApplyOptions1 call apply() for 1 option, as ApplyOptions does,
+// but without the variadic slice of options, which escapes to the heap if ApplyOptions is called indirectly.
+func (o *FixedArity) ApplyOptions1(opt1 FixedArityOption) *FixedArity {
+	if opt1 != nil {
+		opt1.apply(o)
+	}
+	return o
+}
+
+// ApplyOptions2 call apply() for 2 options one by one, as ApplyOptions does,
+// but without the variadic slice of options, which escapes to the heap if ApplyOptions is called indirectly.
+func (o *FixedArity) ApplyOptions2(opt1, opt2 FixedArityOption) *FixedArity {
+	if opt1 != nil {
+		opt1.apply(o)
+	}
+	if opt2 != nil {
+		opt2.apply(o)
+	}
+	return o
+}
+
+// ApplyOptions3 call apply() for 3 options one by one, as ApplyOptions does,
+// but without the variadic slice of options, which escapes to the heap if ApplyOptions is called indirectly.
+func (o *FixedArity) ApplyOptions3(opt1, opt2, opt3 FixedArityOption) *FixedArity {
+	if opt1 != nil {
+		opt1.apply(o)
+	}
+	if opt2 != nil {
+		opt2.apply(o)
+	}
+	if opt3 != nil {
+		opt3.apply(o)
+	}
+	return o
+}
+
+// WithFixedArity sets FixedArity.
+func WithFixedArity(v FixedArity) FixedArityOption {
+	return FixedArityOptionFunc(func(o *FixedArity) {
+		*o = v
+	})
+}
+
+// _default_FixedArity_value is the default of FixedArity, the zero value but for the fields with a default.
+var _default_FixedArity_value = func() (val FixedArity) {
+	return
+}()
+
+// WithFixedArityDefaults resets FixedArity to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithFixedArityDefaults(), finalOverrides...).
+func WithFixedArityDefaults() FixedArityOption {
+	return FixedArityOptionFunc(func(o *FixedArity) {
+		*o = _default_FixedArity_value
+	})
+}
+
+// WithFixedArityName sets Name in FixedArity.
+func WithFixedArityName(v string) FixedArityOption {
+	return FixedArityOptionFunc(func(o *FixedArity) {
+		o.Name = v
+	})
+}
+
+// WithFixedArityPort sets Port in FixedArity.
+func WithFixedArityPort(v int) FixedArityOption {
+	return FixedArityOptionFunc(func(o *FixedArity) {
+		o.Port = v
+	})
+}
+
+// WithFixedArityTags appends Tags in FixedArity.
+func WithFixedArityTags(v ...string) FixedArityOption {
+	return FixedArityOptionFunc(func(o *FixedArity) {
+		o.Tags = append(o.Tags, v...)
+	})
+}
+
+// WithFixedArityTagsReplace sets Tags in FixedArity.
+func WithFixedArityTagsReplace(v ...string) FixedArityOption {
+	return FixedArityOptionFunc(func(o *FixedArity) {
+		o.Tags = v
+	})
+}
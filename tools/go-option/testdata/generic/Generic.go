@@ -0,0 +1,42 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options of a struct with type parameters, constrained by another package, a union and a core type.
+
+package main
+
+import (
+	"cmp"
+	"fmt"
+)
+
+type Number interface {
+	~int | ~int64 | ~float64
+}
+
+//go:generate go-option -type "Generic"
+type Generic[K cmp.Ordered, V Number, S ~[]K] struct {
+	Name    string
+	entries map[K]V
+	evict   func(K, V)
+	keys    S
+}
+
+type Keys []string
+
+func NewGeneric[K cmp.Ordered, V Number, S ~[]K](opts ...GenericOption[K, V, S]) *Generic[K, V, S] {
+	return (&Generic[K, V, S]{}).ApplyOptions(opts...)
+}
+
+func main() {
+	var evicted []string
+	g := NewGeneric(WithGenericName[string, int, Keys]("Name"),
+		WithGenericEntries[string, int, Keys](map[string]int{"a": 1}),
+		WithGenericEvict[string, int, Keys](func(k string, _ int) { evicted = append(evicted, k) }),
+		WithGenericKeys[string, int](Keys{"a", "b"}))
+	g.evict("a", g.entries["a"])
+	if g.Name != "Name" || g.entries["a"] != 1 || len(g.keys) != 2 || len(evicted) != 1 {
+		panic(fmt.Sprintf("Generic.go: %+v", g))
+	}
+}
@@ -0,0 +1,96 @@
+// Code generated by "go-option -type Generic"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "cmp"
+
+// A GenericOption sets options.
+type GenericOption[K cmp.Ordered, V Number, S ~[]K] interface {
+	apply(*Generic[K, V, S])
+}
+
+// EmptyGenericOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyGenericOption[K cmp.Ordered, V Number, S ~[]K] struct{}
+
+func (EmptyGenericOption[K, V, S]) apply(*Generic[K, V, S]) {}
+
+// GenericOptionFunc wraps a function that modifies Generic[K, V, S] into an
+// implementation of the GenericOption[K cmp.Ordered, V Number, S ~[]K] interface.
+type GenericOptionFunc[K cmp.Ordered, V Number, S ~[]K] func(*Generic[K, V, S])
+
+func (f GenericOptionFunc[K, V, S]) apply(do *Generic[K, V, S]) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Generic[K, V, S]) ApplyOptions(options ...GenericOption[K, V, S]) *Generic[K, V, S] {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithGeneric sets Generic.
+func WithGeneric[K cmp.Ordered, V Number, S ~[]K](v Generic[K, V, S]) GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		*o = v
+	})
+}
+
+// WithGenericDefaults resets Generic[K, V, S] to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithGenericDefaults(), finalOverrides...).
+func WithGenericDefaults[K cmp.Ordered, V Number, S ~[]K]() GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		*o = Generic[K, V, S]{}
+	})
+}
+
+// WithGenericName sets Name in Generic[K, V, S].
+func WithGenericName[K cmp.Ordered, V Number, S ~[]K](v string) GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		o.Name = v
+	})
+}
+
+// WithGenericEntries appends entries in Generic[K, V, S].
+func WithGenericEntries[K cmp.Ordered, V Number, S ~[]K](m map[K]V) GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		if o.entries == nil {
+			o.entries = m
+			return
+		}
+		for k, v := range m {
+			o.entries[k] = v
+		}
+	})
+}
+
+// WithGenericEntriesReplace sets entries in Generic[K, V, S].
+func WithGenericEntriesReplace[K cmp.Ordered, V Number, S ~[]K](v map[K]V) GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		o.entries = v
+	})
+}
+
+// WithGenericEvict sets evict in Generic[K, V, S].
+func WithGenericEvict[K cmp.Ordered, V Number, S ~[]K](v func(K, V)) GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		o.evict = v
+	})
+}
+
+// WithGenericKeys sets keys in Generic[K, V, S].
+func WithGenericKeys[K cmp.Ordered, V Number, S ~[]K](v S) GenericOption[K, V, S] {
+	return GenericOptionFunc[K, V, S](func(o *Generic[K, V, S]) {
+		o.keys = v
+	})
+}
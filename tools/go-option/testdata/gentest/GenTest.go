@@ -0,0 +1,33 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options tested by the companion test generated by -gentest.
+
+package main
+
+import (
+	"fmt"
+	time_ "time"
+)
+
+type Level int8
+
+//go:generate go-option -type "GenTest" -gentest
+type GenTest struct {
+	Name    string
+	Level   Level
+	Enabled bool
+	ratio   float64
+	Timeout time_.Duration
+	tags    []string // not of a simple kind, not tested
+	Limit   *int     // not of a simple kind, not tested
+}
+
+func main() {
+	var g GenTest
+	g.ApplyOptions(WithGenTestName("Name"), WithGenTestLevel(2), WithGenTestRatio(0.5))
+	if g.Name != "Name" || g.Level != 2 || g.ratio != 0.5 {
+		panic(fmt.Sprintf("GenTest.go: got %+v", g))
+	}
+}
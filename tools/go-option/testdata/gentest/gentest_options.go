@@ -0,0 +1,128 @@
+// Code generated by "go-option -type GenTest -gentest"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import time_ "time"
+
+// A GenTestOption sets options.
+type GenTestOption interface {
+	apply(*GenTest)
+}
+
+// EmptyGenTestOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyGenTestOption struct{}
+
+func (EmptyGenTestOption) apply(*GenTest) {}
+
+// GenTestOptionFunc wraps a function that modifies GenTest into an
+// implementation of the GenTestOption interface.
+type GenTestOptionFunc func(*GenTest)
+
+func (f GenTestOptionFunc) apply(do *GenTest) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *GenTest) ApplyOptions(options ...GenTestOption) *GenTest {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithGenTest sets GenTest.
+func WithGenTest(v GenTest) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		*o = v
+	})
+}
+
+// _default_GenTest_value is the default of GenTest, the zero value but for the fields with a default.
+var _default_GenTest_value = func() (val GenTest) {
+	return
+}()
+
+// WithGenTestDefaults resets GenTest to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithGenTestDefaults(), finalOverrides...).
+func WithGenTestDefaults() GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		*o = _default_GenTest_value
+	})
+}
+
+// WithGenTestName sets Name in GenTest.
+func WithGenTestName(v string) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.Name = v
+	})
+}
+
+// WithGenTestLevel sets Level in GenTest.
+func WithGenTestLevel(v Level) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.Level = v
+	})
+}
+
+// WithGenTestEnabled sets Enabled in GenTest.
+func WithGenTestEnabled(v bool) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.Enabled = v
+	})
+}
+
+// WithGenTestRatio sets ratio in GenTest.
+func WithGenTestRatio(v float64) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.ratio = v
+	})
+}
+
+// WithGenTestTimeout sets Timeout in GenTest.
+func WithGenTestTimeout(v time_.Duration) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.Timeout = v
+	})
+}
+
+// WithGenTestTags appends tags in GenTest.
+// not of a simple kind, not tested
+func WithGenTestTags(v ...string) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.tags = append(o.tags, v...)
+	})
+}
+
+// WithGenTestTagsReplace sets tags in GenTest.
+// not of a simple kind, not tested
+func WithGenTestTagsReplace(v ...string) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.tags = v
+	})
+}
+
+// WithGenTestLimit sets Limit in GenTest.
+// not of a simple kind, not tested
+func WithGenTestLimit(v *int) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		o.Limit = v
+	})
+}
+
+// WithGenTestLimitValue sets Limit in GenTest to a pointer to a copy of v.
+// not of a simple kind, not tested
+func WithGenTestLimitValue(v int) GenTestOption {
+	return GenTestOptionFunc(func(o *GenTest) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Limit = &v
+	})
+}
@@ -0,0 +1,64 @@
+// Code generated by "go-option -type GenTest -gentest"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import (
+	"reflect"
+	"testing"
+
+	time_ "time"
+)
+
+// TestGenTestOptions tests that the option of each field of GenTest of a simple kind sets the field.
+func TestGenTestOptions(t *testing.T) {
+	t.Run("WithGenTestName", func(t *testing.T) {
+		var o GenTest
+		var want string = "Name"
+		o.ApplyOptions(WithGenTestName(want))
+		if o.Name != want {
+			t.Errorf("WithGenTestName(%v): got %v, want %v", want, o.Name, want)
+		}
+	})
+	t.Run("WithGenTestLevel", func(t *testing.T) {
+		var o GenTest
+		var want Level = 7
+		o.ApplyOptions(WithGenTestLevel(want))
+		if o.Level != want {
+			t.Errorf("WithGenTestLevel(%v): got %v, want %v", want, o.Level, want)
+		}
+	})
+	t.Run("WithGenTestEnabled", func(t *testing.T) {
+		var o GenTest
+		var want bool = true
+		o.ApplyOptions(WithGenTestEnabled(want))
+		if o.Enabled != want {
+			t.Errorf("WithGenTestEnabled(%v): got %v, want %v", want, o.Enabled, want)
+		}
+	})
+	t.Run("WithGenTestRatio", func(t *testing.T) {
+		var o GenTest
+		var want float64 = 1.5
+		o.ApplyOptions(WithGenTestRatio(want))
+		if o.ratio != want {
+			t.Errorf("WithGenTestRatio(%v): got %v, want %v", want, o.ratio, want)
+		}
+	})
+	t.Run("WithGenTestTimeout", func(t *testing.T) {
+		var o GenTest
+		var want time_.Duration = 5 * time_.Second
+		o.ApplyOptions(WithGenTestTimeout(want))
+		if o.Timeout != want {
+			t.Errorf("WithGenTestTimeout(%v): got %v, want %v", want, o.Timeout, want)
+		}
+	})
+}
+
+// TestGenTestApplyOptionsNil tests that a nil option leaves GenTest unchanged.
+func TestGenTestApplyOptionsNil(t *testing.T) {
+	var got, want GenTest
+	got.ApplyOptions(nil)
+	if !reflect.DeepEqual(&got, &want) {
+		t.Errorf("ApplyOptions(nil): got %+v, want %+v", &got, &want)
+	}
+}
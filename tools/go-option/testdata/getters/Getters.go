@@ -0,0 +1,44 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fields read back by getters, the unexported ones included.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+//go:generate go-option -type "Getters" -getters
+type Getters struct {
+	Name    string
+	timeout time.Duration
+	tags    []string
+	limit   *int
+	Renamed int `option:"size"`
+}
+
+func main() {
+	var g Getters
+	g.ApplyOptions(WithGettersName("Name"), WithGettersTimeout(time.Second), WithGettersTags("a", "b"),
+		WithGettersLimitValue(3), WithGettersSize(5))
+	if got := g.GetName(); got != "Name" {
+		panic(fmt.Sprintf("Getters.go: GetName: got %q, want %q", got, "Name"))
+	}
+	if got := g.GetTimeout(); got != time.Second {
+		panic(fmt.Sprintf("Getters.go: GetTimeout: got %s, want %s", got, time.Second))
+	}
+	if got := g.GetTags(); !slices.Equal(got, []string{"a", "b"}) {
+		panic(fmt.Sprintf("Getters.go: GetTags: got %v, want %v", got, []string{"a", "b"}))
+	}
+	if got := g.GetLimit(); got == nil || *got != 3 {
+		panic(fmt.Sprintf("Getters.go: GetLimit: got %v, want a pointer to 3", got))
+	}
+	// named after the option, as the option tag renames it
+	if got := g.GetSize(); got != 5 {
+		panic(fmt.Sprintf("Getters.go: GetSize: got %d, want %d", got, 5))
+	}
+}
@@ -0,0 +1,135 @@
+// Code generated by "go-option -type Getters -getters"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A GettersOption sets options.
+type GettersOption interface {
+	apply(*Getters)
+}
+
+// EmptyGettersOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyGettersOption struct{}
+
+func (EmptyGettersOption) apply(*Getters) {}
+
+// GettersOptionFunc wraps a function that modifies Getters into an
+// implementation of the GettersOption interface.
+type GettersOptionFunc func(*Getters)
+
+func (f GettersOptionFunc) apply(do *Getters) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Getters) ApplyOptions(options ...GettersOption) *Getters {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithGetters sets Getters.
+func WithGetters(v Getters) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		*o = v
+	})
+}
+
+// _default_Getters_value is the default of Getters, the zero value but for the fields with a default.
+var _default_Getters_value = func() (val Getters) {
+	return
+}()
+
+// WithGettersDefaults resets Getters to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithGettersDefaults(), finalOverrides...).
+func WithGettersDefaults() GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		*o = _default_Getters_value
+	})
+}
+
+// WithGettersName sets Name in Getters.
+func WithGettersName(v string) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		o.Name = v
+	})
+}
+
+// GetName returns Name of o, as set by WithGettersName.
+func (o *Getters) GetName() string {
+	return o.Name
+}
+
+// WithGettersTimeout sets timeout in Getters.
+func WithGettersTimeout(v time.Duration) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		o.timeout = v
+	})
+}
+
+// GetTimeout returns timeout of o, as set by WithGettersTimeout.
+func (o *Getters) GetTimeout() time.Duration {
+	return o.timeout
+}
+
+// WithGettersTags appends tags in Getters.
+func WithGettersTags(v ...string) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		o.tags = append(o.tags, v...)
+	})
+}
+
+// WithGettersTagsReplace sets tags in Getters.
+func WithGettersTagsReplace(v ...string) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		o.tags = v
+	})
+}
+
+// GetTags returns tags of o, as set by WithGettersTags.
+func (o *Getters) GetTags() []string {
+	return o.tags
+}
+
+// WithGettersLimit sets limit in Getters.
+func WithGettersLimit(v *int) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		o.limit = v
+	})
+}
+
+// WithGettersLimitValue sets limit in Getters to a pointer to a copy of v.
+func WithGettersLimitValue(v int) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.limit = &v
+	})
+}
+
+// GetLimit returns limit of o, as set by WithGettersLimit.
+func (o *Getters) GetLimit() *int {
+	return o.limit
+}
+
+// WithGettersSize sets Renamed in Getters.
+func WithGettersSize(v int) GettersOption {
+	return GettersOptionFunc(func(o *Getters) {
+		o.Renamed = v
+	})
+}
+
+// GetSize returns Renamed of o, as set by WithGettersSize.
+func (o *Getters) GetSize() int {
+	return o.Renamed
+}
@@ -0,0 +1,47 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options applied under a mutex, read by Snapshot.
+
+package main
+
+import (
+	"fmt"
+	"sync"
+)
+
+//go:generate go-option -type "Locked" -locked
+type Locked struct {
+	mu sync.Mutex
+
+	Name    string
+	Address string `option:"-"`
+	tags    []string
+}
+
+func NewLocked(opts ...LockedOption) *Locked {
+	return (&Locked{}).ApplyOptions(opts...)
+}
+
+func main() {
+	l := NewLocked(WithLockedName("Name"), WithLockedTags("a"))
+	l.Address = "Address"
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			l.ApplyOptions(WithLockedName(fmt.Sprintf("Name%d", i)))
+			_ = l.Snapshot()
+		}(i)
+	}
+	wg.Wait()
+
+	l.ApplyOptions(WithLockedName("Name"))
+	s := l.Snapshot()
+	if s.Name != "Name" || s.Address != "Address" || len(s.tags) != 1 || s.tags[0] != "a" {
+		panic(fmt.Sprintf("Locked.go: %+v", s))
+	}
+}
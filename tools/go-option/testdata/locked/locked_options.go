@@ -0,0 +1,73 @@
+// Code generated by "go-option -type Locked -locked"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A LockedOption sets options.
+type LockedOption interface {
+	apply(*Locked)
+}
+
+// EmptyLockedOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyLockedOption struct{}
+
+func (EmptyLockedOption) apply(*Locked) {}
+
+// LockedOptionFunc wraps a function that modifies Locked into an
+// implementation of the LockedOption interface.
+type LockedOptionFunc func(*Locked)
+
+func (f LockedOptionFunc) apply(do *Locked) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one,
+// holding o.mu so that options are applied atomically relative to Snapshot.
+func (o *Locked) ApplyOptions(options ...LockedOption) *Locked {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// Snapshot returns a copy of all fields but mu of Locked,
+// taken while holding o.mu.
+// Maps and slices are copied shallowly.
+func (o *Locked) Snapshot() *Locked {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return &Locked{
+		Name:    o.Name,
+		Address: o.Address,
+		tags:    o.tags,
+	}
+}
+
+// WithLockedName sets Name in Locked.
+func WithLockedName(v string) LockedOption {
+	return LockedOptionFunc(func(o *Locked) {
+		o.Name = v
+	})
+}
+
+// WithLockedTags appends tags in Locked.
+func WithLockedTags(v ...string) LockedOption {
+	return LockedOptionFunc(func(o *Locked) {
+		o.tags = append(o.tags, v...)
+	})
+}
+
+// WithLockedTagsReplace sets tags in Locked.
+func WithLockedTagsReplace(v ...string) LockedOption {
+	return LockedOptionFunc(func(o *Locked) {
+		o.tags = v
+	})
+}
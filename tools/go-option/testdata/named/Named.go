@@ -0,0 +1,49 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options recording the names of the fields they set.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+//go:generate go-option -type "Named" -named
+type Named[T comparable] struct {
+	Name    string
+	Timeout time.Duration // +optional-default:5s
+	Tags    []T
+	Labels  map[string]string
+}
+
+func main() {
+	var applied []string
+	NamedOptionAppliedHook = func(name string) { applied = append(applied, name) }
+
+	opts := []NamedOption[int]{
+		WithNamedName[int]("Name"),
+		WithNamedTimeoutDefault[int](),
+		NamedOptionFunc[int](func(n *Named[int]) { n.Tags = nil }),
+		WithNamedTags[int](1, 2),
+		WithNamedLabels[int](map[string]string{"k": "v"}),
+	}
+	want := []string{"Name", "Timeout", "Tags", "Labels"}
+	if names := NamedOptionNames(opts...); !slices.Equal(names, want) {
+		panic(fmt.Sprintf("Named.go: NamedOptionNames = %v, want %v", names, want))
+	}
+
+	n := (&Named[int]{}).ApplyOptions(opts...)
+	if !slices.Equal(applied, want) {
+		panic(fmt.Sprintf("Named.go: applied %v, want %v", applied, want))
+	}
+	if n.Name != "Name" || n.Timeout != 5*time.Second || !slices.Equal(n.Tags, []int{1, 2}) || n.Labels["k"] != "v" {
+		panic(fmt.Sprintf("Named.go: %+v", n))
+	}
+	if name := WithNamedTagsReplace[int]().(interface{ Name() string }).Name(); name != "Tags" {
+		panic(fmt.Sprintf("Named.go: WithNamedTagsReplace().Name() = %q, want Tags", name))
+	}
+}
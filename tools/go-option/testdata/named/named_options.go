@@ -0,0 +1,151 @@
+// Code generated by "go-option -type Named -named"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A NamedOption sets options.
+type NamedOption[T comparable] interface {
+	apply(*Named[T])
+}
+
+// EmptyNamedOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyNamedOption[T comparable] struct{}
+
+func (EmptyNamedOption[T]) apply(*Named[T]) {}
+
+// NamedOptionFunc wraps a function that modifies Named[T] into an
+// implementation of the NamedOption[T comparable] interface.
+type NamedOptionFunc[T comparable] func(*Named[T])
+
+func (f NamedOptionFunc[T]) apply(do *Named[T]) {
+	f(do)
+}
+
+// NamedOptionNamed wraps a NamedOptionFunc[T] with the name of the field it sets,
+// so that applied options can be told apart, such as for logging.
+type NamedOptionNamed[T comparable] struct {
+	NamedOptionFunc[T]
+	name string
+}
+
+// Name returns the name of the field the option sets.
+func (o NamedOptionNamed[T]) Name() string {
+	return o.name
+}
+
+func namedNamedOption[T comparable](name string, f NamedOptionFunc[T]) NamedOption[T] {
+	return NamedOptionNamed[T]{NamedOptionFunc: f, name: name}
+}
+
+// NamedOptionNames returns the names of the fields set by options, in order,
+// skipping options not named.
+func NamedOptionNames[T comparable](options ...NamedOption[T]) []string {
+	var names []string
+	for _, opt := range options {
+		if opt, ok := opt.(interface{ Name() string }); ok {
+			names = append(names, opt.Name())
+		}
+	}
+	return names
+}
+
+// NamedOptionAppliedHook, if not nil, is called by ApplyOptions
+// with the name of every named option applied, in order.
+var NamedOptionAppliedHook func(name string)
+
+// ApplyOptions call apply() for all options one by one
+func (o *Named[T]) ApplyOptions(options ...NamedOption[T]) *Named[T] {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+		if hook := NamedOptionAppliedHook; hook != nil {
+			if opt, ok := opt.(interface{ Name() string }); ok {
+				hook(opt.Name())
+			}
+		}
+	}
+	return o
+}
+
+// WithNamed sets Named.
+func WithNamed[T comparable](v Named[T]) NamedOption[T] {
+	return NamedOptionFunc[T](func(o *Named[T]) {
+		*o = v
+	})
+}
+
+// WithNamedDefaults resets Named[T] to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithNamedDefaults(), finalOverrides...).
+func WithNamedDefaults[T comparable]() NamedOption[T] {
+	return NamedOptionFunc[T](func(o *Named[T]) {
+		*o = Named[T]{}
+		o.Timeout = _default_Named_Timeout_value
+	})
+}
+
+// WithNamedName sets Name in Named[T].
+func WithNamedName[T comparable](v string) NamedOption[T] {
+	return namedNamedOption[T]("Name", NamedOptionFunc[T](func(o *Named[T]) {
+		o.Name = v
+	}))
+}
+
+// WithNamedTimeout sets Timeout in Named[T].
+func WithNamedTimeout[T comparable](v time.Duration) NamedOption[T] {
+	return namedNamedOption[T]("Timeout", NamedOptionFunc[T](func(o *Named[T]) {
+		o.Timeout = v
+	}))
+}
+
+// _default_Named_Timeout_value is the default of Timeout in Named, from "+optional-default:5s".
+var _default_Named_Timeout_value time.Duration = 5 * time.Second
+
+// WithNamedTimeoutDefault sets Timeout in Named[T] to its default 5s.
+func WithNamedTimeoutDefault[T comparable]() NamedOption[T] {
+	return namedNamedOption[T]("Timeout", NamedOptionFunc[T](func(o *Named[T]) {
+		o.Timeout = _default_Named_Timeout_value
+	}))
+}
+
+// WithNamedTags appends Tags in Named[T].
+func WithNamedTags[T comparable](v ...T) NamedOption[T] {
+	return namedNamedOption[T]("Tags", NamedOptionFunc[T](func(o *Named[T]) {
+		o.Tags = append(o.Tags, v...)
+	}))
+}
+
+// WithNamedTagsReplace sets Tags in Named[T].
+func WithNamedTagsReplace[T comparable](v ...T) NamedOption[T] {
+	return namedNamedOption[T]("Tags", NamedOptionFunc[T](func(o *Named[T]) {
+		o.Tags = v
+	}))
+}
+
+// WithNamedLabels appends Labels in Named[T].
+func WithNamedLabels[T comparable](m map[string]string) NamedOption[T] {
+	return namedNamedOption[T]("Labels", NamedOptionFunc[T](func(o *Named[T]) {
+		if o.Labels == nil {
+			o.Labels = m
+			return
+		}
+		for k, v := range m {
+			o.Labels[k] = v
+		}
+	}))
+}
+
+// WithNamedLabelsReplace sets Labels in Named[T].
+func WithNamedLabelsReplace[T comparable](v map[string]string) NamedOption[T] {
+	return namedNamedOption[T]("Labels", NamedOptionFunc[T](func(o *Named[T]) {
+		o.Labels = v
+	}))
+}
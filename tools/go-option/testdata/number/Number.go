@@ -49,6 +49,8 @@ func main() {
 	ck(num, "")
 	num = NewNumber(WithNumberName[int]("Name"))
 	ck(num, "Name")
+	num = NewNumber(WithNumberName[int]("Name"), WithNumberDefaults[int]())
+	ck(num, "")
 }
 
 func ck[T comparable](num *Number[T], str string) {
@@ -44,6 +44,16 @@ func WithNumber[T comparable](v Number[T]) NumberOption[T] {
 	})
 }
 
+// WithNumberDefaults resets Number[T] to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithNumberDefaults(), finalOverrides...).
+func WithNumberDefaults[T comparable]() NumberOption[T] {
+	return NumberOptionFunc[T](func(o *Number[T]) {
+		*o = Number[T]{}
+	})
+}
+
 // WithNumberName sets Name in Number[T].
 // This is Name doc comment
 // This is Name line comment
@@ -81,6 +91,14 @@ func WithNumberPointerType[T comparable](v *[5]T) NumberOption[T] {
 	})
 }
 
+// WithNumberPointerTypeValue sets pointerType in Number[T] to a pointer to a copy of v.
+func WithNumberPointerTypeValue[T comparable](v [5]T) NumberOption[T] {
+	return NumberOptionFunc[T](func(o *Number[T]) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.pointerType = &v
+	})
+}
+
 // WithNumberStructType sets structType in Number[T].
 func WithNumberStructType[T comparable](v time_.Time) NumberOption[T] {
 	return NumberOptionFunc[T](func(o *Number[T]) {
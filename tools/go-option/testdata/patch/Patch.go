@@ -0,0 +1,70 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Partial updates applied by a patch of pointers to fields.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+//go:generate go-option -type "Patch" -patch
+type Patch struct {
+	Name     string            `json:"name"`
+	Timeout  time.Duration     `json:"timeout,omitempty"`
+	Tags     []string          `json:"tags"`
+	Labels   map[string]string `json:"labels"`
+	Parent   *Patch            `json:"parent"`
+	Enabled  bool
+	Internal string `option:"-"`
+	retries  int
+}
+
+func main() {
+	base := Patch{
+		Name:     "base",
+		Timeout:  time.Second,
+		Tags:     []string{"a"},
+		Labels:   map[string]string{"k": "v"},
+		Enabled:  true,
+		Internal: "internal",
+		retries:  3,
+	}
+
+	// a patch decoded from a JSON Merge Patch updates the members present only
+	got := base
+	var patch PatchPatch
+	if err := json.Unmarshal([]byte(`{"name": "patched", "tags": [], "timeout": null, "Enabled": false}`), &patch); err != nil {
+		panic(fmt.Sprintf("Patch.go: json.Unmarshal: %s", err))
+	}
+	got.ApplyPatch(&patch)
+	want := base
+	want.Name = "patched"
+	want.Tags = []string{}
+	want.Enabled = false
+	if !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("Patch.go: ApplyPatch(%s): got %+v, want %+v", "JSON", got, want))
+	}
+
+	// unexported fields are patched too, and nil pointers left unchanged
+	retries := 5
+	got = base
+	got.ApplyPatch(&PatchPatch{retries: &retries, Parent: &want.Parent})
+	want = base
+	want.retries = 5
+	if !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("Patch.go: ApplyPatch(%s): got %+v, want %+v", "retries", got, want))
+	}
+
+	// a nil or empty patch changes nothing
+	got = base
+	got.ApplyPatch(nil).ApplyPatch(&PatchPatch{})
+	if !reflect.DeepEqual(got, base) {
+		panic(fmt.Sprintf("Patch.go: ApplyPatch(%s): got %+v, want %+v", "nil", got, base))
+	}
+}
@@ -0,0 +1,179 @@
+// Code generated by "go-option -type Patch -patch"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A PatchOption sets options.
+type PatchOption interface {
+	apply(*Patch)
+}
+
+// EmptyPatchOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyPatchOption struct{}
+
+func (EmptyPatchOption) apply(*Patch) {}
+
+// PatchOptionFunc wraps a function that modifies Patch into an
+// implementation of the PatchOption interface.
+type PatchOptionFunc func(*Patch)
+
+func (f PatchOptionFunc) apply(do *Patch) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Patch) ApplyOptions(options ...PatchOption) *Patch {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithPatch sets Patch.
+func WithPatch(v Patch) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		*o = v
+	})
+}
+
+// _default_Patch_value is the default of Patch, the zero value but for the fields with a default.
+var _default_Patch_value = func() (val Patch) {
+	return
+}()
+
+// WithPatchDefaults resets Patch to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithPatchDefaults(), finalOverrides...).
+func WithPatchDefaults() PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		*o = _default_Patch_value
+	})
+}
+
+// PatchPatch holds the fields of Patch to update by ApplyPatch, as pointers:
+// a nil field is left unchanged, such as to apply a JSON Merge Patch decoded into it,
+// where null and absent members both leave the field unchanged.
+type PatchPatch struct {
+	Name    *string            `json:"name"`
+	Timeout *time.Duration     `json:"timeout,omitempty"`
+	Tags    *[]string          `json:"tags"`
+	Labels  *map[string]string `json:"labels"`
+	Parent  **Patch            `json:"parent"`
+	Enabled *bool
+	retries *int
+}
+
+// ApplyPatch sets the fields of o set in patch, leaving the others unchanged.
+func (o *Patch) ApplyPatch(patch *PatchPatch) *Patch {
+	if patch == nil {
+		return o
+	}
+	if patch.Name != nil {
+		o.Name = *patch.Name
+	}
+	if patch.Timeout != nil {
+		o.Timeout = *patch.Timeout
+	}
+	if patch.Tags != nil {
+		o.Tags = *patch.Tags
+	}
+	if patch.Labels != nil {
+		o.Labels = *patch.Labels
+	}
+	if patch.Parent != nil {
+		o.Parent = *patch.Parent
+	}
+	if patch.Enabled != nil {
+		o.Enabled = *patch.Enabled
+	}
+	if patch.retries != nil {
+		o.retries = *patch.retries
+	}
+	return o
+}
+
+// WithPatchName sets Name in Patch.
+func WithPatchName(v string) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Name = v
+	})
+}
+
+// WithPatchTimeout sets Timeout in Patch.
+func WithPatchTimeout(v time.Duration) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Timeout = v
+	})
+}
+
+// WithPatchTags appends Tags in Patch.
+func WithPatchTags(v ...string) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Tags = append(o.Tags, v...)
+	})
+}
+
+// WithPatchTagsReplace sets Tags in Patch.
+func WithPatchTagsReplace(v ...string) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Tags = v
+	})
+}
+
+// WithPatchLabels appends Labels in Patch.
+func WithPatchLabels(m map[string]string) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		if o.Labels == nil {
+			o.Labels = m
+			return
+		}
+		for k, v := range m {
+			o.Labels[k] = v
+		}
+	})
+}
+
+// WithPatchLabelsReplace sets Labels in Patch.
+func WithPatchLabelsReplace(v map[string]string) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Labels = v
+	})
+}
+
+// WithPatchParent sets Parent in Patch.
+func WithPatchParent(v *Patch) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Parent = v
+	})
+}
+
+// WithPatchParentValue sets Parent in Patch to a pointer to a copy of v.
+func WithPatchParentValue(v Patch) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Parent = &v
+	})
+}
+
+// WithPatchEnabled sets Enabled in Patch.
+func WithPatchEnabled(v bool) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.Enabled = v
+	})
+}
+
+// WithPatchRetries sets retries in Patch.
+func WithPatchRetries(v int) PatchOption {
+	return PatchOptionFunc(func(o *Patch) {
+		o.retries = v
+	})
+}
@@ -44,6 +44,16 @@ func WithPill[T comparable](v Pill[T]) PillOption[T] {
 	})
 }
 
+// WithPillDefaults resets Pill[T] to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithPillDefaults(), finalOverrides...).
+func WithPillDefaults[T comparable]() PillOption[T] {
+	return PillOptionFunc[T](func(o *Pill[T]) {
+		*o = Pill[T]{}
+	})
+}
+
 // WithPillName sets Name in Pill[T].
 // This is Name doc comment
 // This is Name line comment
@@ -95,6 +105,14 @@ func WithPillPointerType[T comparable](v *[5]T) PillOption[T] {
 	})
 }
 
+// WithPillPointerTypeValue sets pointerType in Pill[T] to a pointer to a copy of v.
+func WithPillPointerTypeValue[T comparable](v [5]T) PillOption[T] {
+	return PillOptionFunc[T](func(o *Pill[T]) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.pointerType = &v
+	})
+}
+
 // WithPillFuncType sets funcType in Pill[T].
 func WithPillFuncType[T comparable](v func()) PillOption[T] {
 	return PillOptionFunc[T](func(o *Pill[T]) {
@@ -0,0 +1,44 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options taking values for pointer fields.
+
+package main
+
+import (
+	"fmt"
+	"time"
+)
+
+//go:generate go-option -type "Pointer"
+type Pointer struct {
+	Retries *int
+	Timeout *time.Duration
+	Parent  *Pointer
+	Name    string
+}
+
+func main() {
+	retries := 1
+	var got Pointer
+	got.ApplyOptions(WithPointerRetries(&retries))
+	if got.Retries != &retries {
+		panic(fmt.Sprintf("Pointer.go: WithPointerRetries: got %p, want %p", got.Retries, &retries))
+	}
+
+	opt := WithPointerTimeoutValue(time.Second)
+	var a, b Pointer
+	a.ApplyOptions(WithPointerRetriesValue(3), opt, WithPointerParentValue(Pointer{Name: "parent"}))
+	b.ApplyOptions(opt)
+	if a.Retries == nil || *a.Retries != 3 || a.Timeout == nil || *a.Timeout != time.Second ||
+		a.Parent == nil || a.Parent.Name != "parent" {
+		panic(fmt.Sprintf("Pointer.go: With<Field>Value: got %+v", a))
+	}
+
+	// every application stores a pointer of its own
+	*a.Timeout = time.Minute
+	if *b.Timeout != time.Second {
+		panic(fmt.Sprintf("Pointer.go: With<Field>Value applied twice: got %s shared, want %s", *b.Timeout, time.Second))
+	}
+}
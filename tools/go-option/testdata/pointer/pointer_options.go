@@ -0,0 +1,112 @@
+// Code generated by "go-option -type Pointer"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+import "time"
+
+// A PointerOption sets options.
+type PointerOption interface {
+	apply(*Pointer)
+}
+
+// EmptyPointerOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyPointerOption struct{}
+
+func (EmptyPointerOption) apply(*Pointer) {}
+
+// PointerOptionFunc wraps a function that modifies Pointer into an
+// implementation of the PointerOption interface.
+type PointerOptionFunc func(*Pointer)
+
+func (f PointerOptionFunc) apply(do *Pointer) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Pointer) ApplyOptions(options ...PointerOption) *Pointer {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithPointer sets Pointer.
+func WithPointer(v Pointer) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		*o = v
+	})
+}
+
+// _default_Pointer_value is the default of Pointer, the zero value but for the fields with a default.
+var _default_Pointer_value = func() (val Pointer) {
+	return
+}()
+
+// WithPointerDefaults resets Pointer to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithPointerDefaults(), finalOverrides...).
+func WithPointerDefaults() PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		*o = _default_Pointer_value
+	})
+}
+
+// WithPointerRetries sets Retries in Pointer.
+func WithPointerRetries(v *int) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		o.Retries = v
+	})
+}
+
+// WithPointerRetriesValue sets Retries in Pointer to a pointer to a copy of v.
+func WithPointerRetriesValue(v int) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Retries = &v
+	})
+}
+
+// WithPointerTimeout sets Timeout in Pointer.
+func WithPointerTimeout(v *time.Duration) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		o.Timeout = v
+	})
+}
+
+// WithPointerTimeoutValue sets Timeout in Pointer to a pointer to a copy of v.
+func WithPointerTimeoutValue(v time.Duration) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Timeout = &v
+	})
+}
+
+// WithPointerParent sets Parent in Pointer.
+func WithPointerParent(v *Pointer) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		o.Parent = v
+	})
+}
+
+// WithPointerParentValue sets Parent in Pointer to a pointer to a copy of v.
+func WithPointerParentValue(v Pointer) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		v := v // a copy per application, not shared by the structs the option is applied to
+		o.Parent = &v
+	})
+}
+
+// WithPointerName sets Name in Pointer.
+func WithPointerName(v string) PointerOption {
+	return PointerOptionFunc(func(o *Pointer) {
+		o.Name = v
+	})
+}
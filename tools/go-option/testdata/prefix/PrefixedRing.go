@@ -0,0 +1,29 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options named by -prefix instead of With<Type>, the type name trimmed by -trimprefix.
+
+package main
+
+import (
+	"fmt"
+)
+
+//go:generate go-option -type "PrefixedRing" -trimprefix "Prefixed" -prefix "Set"
+type PrefixedRing struct {
+	NumReps int
+	Name    string `option:",short"`
+	nodes   []string
+}
+
+func NewPrefixedRing(opts ...RingOption) *PrefixedRing {
+	return (&PrefixedRing{}).ApplyOptions(opts...)
+}
+
+func main() {
+	r := NewPrefixedRing(SetNumReps(3), SetName("Name"), SetNodes("a", "b"), SetNodesReplace("c"))
+	if r.NumReps != 3 || r.Name != "Name" || len(r.nodes) != 1 || r.nodes[0] != "c" {
+		panic(fmt.Sprintf("PrefixedRing.go: %+v", r))
+	}
+}
@@ -0,0 +1,86 @@
+// Code generated by "go-option -type PrefixedRing -trimprefix=Prefixed -prefix=Set"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A RingOption sets options.
+type RingOption interface {
+	apply(*PrefixedRing)
+}
+
+// EmptyRingOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyRingOption struct{}
+
+func (EmptyRingOption) apply(*PrefixedRing) {}
+
+// RingOptionFunc wraps a function that modifies PrefixedRing into an
+// implementation of the RingOption interface.
+type RingOptionFunc func(*PrefixedRing)
+
+func (f RingOptionFunc) apply(do *PrefixedRing) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *PrefixedRing) ApplyOptions(options ...RingOption) *PrefixedRing {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithPrefixedRing sets PrefixedRing.
+func WithPrefixedRing(v PrefixedRing) RingOption {
+	return RingOptionFunc(func(o *PrefixedRing) {
+		*o = v
+	})
+}
+
+// _default_PrefixedRing_value is the default of PrefixedRing, the zero value but for the fields with a default.
+var _default_PrefixedRing_value = func() (val PrefixedRing) {
+	return
+}()
+
+// WithPrefixedRingDefaults resets PrefixedRing to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithPrefixedRingDefaults(), finalOverrides...).
+func WithPrefixedRingDefaults() RingOption {
+	return RingOptionFunc(func(o *PrefixedRing) {
+		*o = _default_PrefixedRing_value
+	})
+}
+
+// SetNumReps sets NumReps in PrefixedRing.
+func SetNumReps(v int) RingOption {
+	return RingOptionFunc(func(o *PrefixedRing) {
+		o.NumReps = v
+	})
+}
+
+// SetName sets Name in PrefixedRing.
+func SetName(v string) RingOption {
+	return RingOptionFunc(func(o *PrefixedRing) {
+		o.Name = v
+	})
+}
+
+// SetNodes appends nodes in PrefixedRing.
+func SetNodes(v ...string) RingOption {
+	return RingOptionFunc(func(o *PrefixedRing) {
+		o.nodes = append(o.nodes, v...)
+	})
+}
+
+// SetNodesReplace sets nodes in PrefixedRing.
+func SetNodesReplace(v ...string) RingOption {
+	return RingOptionFunc(func(o *PrefixedRing) {
+		o.nodes = v
+	})
+}
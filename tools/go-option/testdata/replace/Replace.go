@@ -0,0 +1,82 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Slice and map fields appended to or replaced only, by the option tag.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"slices"
+)
+
+//go:generate go-option -type "Replace"
+type Replace struct {
+	Tags    []string          // both
+	Hosts   []string          `option:",replace"`
+	Plugins []string          `option:",append"`
+	Labels  map[string]string `option:",replace"`
+	Headers map[string]string `option:",append"`
+	Both    []int             `option:",append,replace"`
+	Renamed []int             `option:"Ports,replace"`
+	Limits  map[string]int
+}
+
+func main() {
+	var got Replace
+	got.ApplyOptions(
+		WithReplaceHosts("a", "b"), WithReplaceHosts("c"),
+		WithReplacePlugins("a"), WithReplacePlugins("b"),
+		WithReplaceLabels(map[string]string{"a": "1"}), WithReplaceLabels(map[string]string{"b": "2"}),
+		WithReplaceHeaders(map[string]string{"a": "1"}), WithReplaceHeaders(map[string]string{"b": "2"}),
+		WithReplaceBoth(1), WithReplaceBothReplace(2),
+		WithReplacePorts(80), WithReplacePorts(443),
+	)
+	want := Replace{
+		Hosts:   []string{"c"},
+		Plugins: []string{"a", "b"},
+		Labels:  map[string]string{"b": "2"},
+		Headers: map[string]string{"a": "1", "b": "2"},
+		Both:    []int{2},
+		Renamed: []int{443},
+	}
+	if !reflect.DeepEqual(got, want) {
+		panic(fmt.Sprintf("Replace.go: ApplyOptions: got %+v, want %+v", got, want))
+	}
+
+	// only the options of the behavior tagged are generated
+	_, file, _, _ := runtime.Caller(0)
+	// named replace_options.go by go generate, Replace_options.go by the end-to-end test
+	options, _ := filepath.Glob(filepath.Join(filepath.Dir(file), "[Rr]eplace_options.go"))
+	if len(options) != 1 {
+		panic(fmt.Sprintf("Replace.go: got options files %v, want one", options))
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), options[0], nil, 0)
+	if err != nil {
+		panic(fmt.Sprintf("Replace.go: %s", err))
+	}
+	var funcs []string
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+			funcs = append(funcs, fn.Name.Name)
+		}
+	}
+	for _, name := range []string{"WithReplaceHostsReplace", "WithReplacePluginsReplace", "WithReplaceLabelsReplace",
+		"WithReplaceHeadersReplace", "WithReplacePortsReplace"} {
+		if slices.Contains(funcs, name) {
+			panic(fmt.Sprintf("Replace.go: got option %s, want none", name))
+		}
+	}
+	for _, name := range []string{"WithReplaceTags", "WithReplaceTagsReplace", "WithReplaceLimits", "WithReplaceLimitsReplace"} {
+		if !slices.Contains(funcs, name) {
+			panic(fmt.Sprintf("Replace.go: got no option %s, want both by default", name))
+		}
+	}
+}
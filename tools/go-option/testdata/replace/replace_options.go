@@ -0,0 +1,149 @@
+// Code generated by "go-option -type Replace"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A ReplaceOption sets options.
+type ReplaceOption interface {
+	apply(*Replace)
+}
+
+// EmptyReplaceOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyReplaceOption struct{}
+
+func (EmptyReplaceOption) apply(*Replace) {}
+
+// ReplaceOptionFunc wraps a function that modifies Replace into an
+// implementation of the ReplaceOption interface.
+type ReplaceOptionFunc func(*Replace)
+
+func (f ReplaceOptionFunc) apply(do *Replace) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Replace) ApplyOptions(options ...ReplaceOption) *Replace {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithReplace sets Replace.
+func WithReplace(v Replace) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		*o = v
+	})
+}
+
+// _default_Replace_value is the default of Replace, the zero value but for the fields with a default.
+var _default_Replace_value = func() (val Replace) {
+	return
+}()
+
+// WithReplaceDefaults resets Replace to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithReplaceDefaults(), finalOverrides...).
+func WithReplaceDefaults() ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		*o = _default_Replace_value
+	})
+}
+
+// WithReplaceTags appends Tags in Replace.
+// both
+func WithReplaceTags(v ...string) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Tags = append(o.Tags, v...)
+	})
+}
+
+// WithReplaceTagsReplace sets Tags in Replace.
+// both
+func WithReplaceTagsReplace(v ...string) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Tags = v
+	})
+}
+
+// WithReplaceHosts sets Hosts in Replace.
+func WithReplaceHosts(v ...string) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Hosts = v
+	})
+}
+
+// WithReplacePlugins appends Plugins in Replace.
+func WithReplacePlugins(v ...string) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Plugins = append(o.Plugins, v...)
+	})
+}
+
+// WithReplaceLabels sets Labels in Replace.
+func WithReplaceLabels(v map[string]string) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Labels = v
+	})
+}
+
+// WithReplaceHeaders appends Headers in Replace.
+func WithReplaceHeaders(m map[string]string) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		if o.Headers == nil {
+			o.Headers = m
+			return
+		}
+		for k, v := range m {
+			o.Headers[k] = v
+		}
+	})
+}
+
+// WithReplaceBoth appends Both in Replace.
+func WithReplaceBoth(v ...int) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Both = append(o.Both, v...)
+	})
+}
+
+// WithReplaceBothReplace sets Both in Replace.
+func WithReplaceBothReplace(v ...int) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Both = v
+	})
+}
+
+// WithReplacePorts sets Renamed in Replace.
+func WithReplacePorts(v ...int) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Renamed = v
+	})
+}
+
+// WithReplaceLimits appends Limits in Replace.
+func WithReplaceLimits(m map[string]int) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		if o.Limits == nil {
+			o.Limits = m
+			return
+		}
+		for k, v := range m {
+			o.Limits[k] = v
+		}
+	})
+}
+
+// WithReplaceLimitsReplace sets Limits in Replace.
+func WithReplaceLimitsReplace(v map[string]int) ReplaceOption {
+	return ReplaceOptionFunc(func(o *Replace) {
+		o.Limits = v
+	})
+}
@@ -0,0 +1,58 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Fields skipped by the option:"-" tag.
+
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+//go:generate go-option -type "Skipped"
+type Skipped struct {
+	Name string
+
+	mu    sync.Mutex        `option:"-"`
+	cache map[string]string `option:"-"`
+	Hits  int               `json:"hits" option:"-"`
+}
+
+func main() {
+	got := Skipped{cache: map[string]string{"k": "v"}, Hits: 1}
+	got.ApplyOptions(WithSkippedName("name"))
+	if got.Name != "name" || got.cache["k"] != "v" || got.Hits != 1 {
+		panic(fmt.Sprintf("Skipped.go: ApplyOptions: got %+v", &got))
+	}
+
+	// no option is generated for the fields skipped
+	_, file, _, _ := runtime.Caller(0)
+	// named skipped_options.go by go generate, Skipped_options.go by the end-to-end test
+	options, _ := filepath.Glob(filepath.Join(filepath.Dir(file), "[Ss]kipped_options.go"))
+	if len(options) != 1 {
+		panic(fmt.Sprintf("Skipped.go: got options files %v, want one", options))
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), options[0], nil, 0)
+	if err != nil {
+		panic(fmt.Sprintf("Skipped.go: %s", err))
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		for _, skipped := range []string{"Mu", "Cache", "Hits"} {
+			if strings.Contains(fn.Name.Name, skipped) {
+				panic(fmt.Sprintf("Skipped.go: got option %s for a field skipped", fn.Name.Name))
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+// Code generated by "go-option -type Skipped"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A SkippedOption sets options.
+type SkippedOption interface {
+	apply(*Skipped)
+}
+
+// EmptySkippedOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptySkippedOption struct{}
+
+func (EmptySkippedOption) apply(*Skipped) {}
+
+// SkippedOptionFunc wraps a function that modifies Skipped into an
+// implementation of the SkippedOption interface.
+type SkippedOptionFunc func(*Skipped)
+
+func (f SkippedOptionFunc) apply(do *Skipped) {
+	f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Skipped) ApplyOptions(options ...SkippedOption) *Skipped {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// WithSkipped sets Skipped.
+func WithSkipped(v Skipped) SkippedOption {
+	return SkippedOptionFunc(func(o *Skipped) {
+		*o = v
+	})
+}
+
+// _default_Skipped_value is the default of Skipped, the zero value but for the fields with a default.
+var _default_Skipped_value = func() (val Skipped) {
+	return
+}()
+
+// WithSkippedDefaults resets Skipped to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithSkippedDefaults(), finalOverrides...).
+func WithSkippedDefaults() SkippedOption {
+	return SkippedOptionFunc(func(o *Skipped) {
+		*o = _default_Skipped_value
+	})
+}
+
+// WithSkippedName sets Name in Skipped.
+func WithSkippedName(v string) SkippedOption {
+	return SkippedOptionFunc(func(o *Skipped) {
+		o.Name = v
+	})
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 The searKing Author. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Options validated by ApplyOptionsReturnError, stopping at the first failing option.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+//go:generate go-option -type "Validate" -validate
+type Validate struct {
+	Host string
+	Port int
+}
+
+var errInvalidPort = errors.New("invalid port")
+
+// WithValidatePortChecked sets Port in Validate, failing unless port is in [1, 65535].
+func WithValidatePortChecked(port int) ValidateOption {
+	return ValidateOptionCheckFunc(func(o *Validate) error {
+		if port < 1 || port > 65535 {
+			return fmt.Errorf("port %d: %w", port, errInvalidPort)
+		}
+		o.Port = port
+		return nil
+	})
+}
+
+func main() {
+	// the options generated never fail
+	var got Validate
+	if err := got.ApplyOptionsReturnError(WithValidateHost("localhost"), nil, WithValidatePortChecked(8080)); err != nil {
+		panic(fmt.Sprintf("Validate.go: ApplyOptionsReturnError: got %v, want nil", err))
+	}
+	if want := (Validate{Host: "localhost", Port: 8080}); got != want {
+		panic(fmt.Sprintf("Validate.go: ApplyOptionsReturnError: got %+v, want %+v", got, want))
+	}
+
+	// stops at the first failing option, the options before it applied already
+	got = Validate{}
+	err := got.ApplyOptionsReturnError(WithValidateHost("example.com"), WithValidatePortChecked(0), WithValidatePort(80))
+	if !errors.Is(err, errInvalidPort) {
+		panic(fmt.Sprintf("Validate.go: ApplyOptionsReturnError(%d): got %v, want %v", 0, err, errInvalidPort))
+	}
+	if want := (Validate{Host: "example.com"}); got != want {
+		panic(fmt.Sprintf("Validate.go: ApplyOptionsReturnError(%d): got %+v, want %+v", 0, got, want))
+	}
+
+	// ApplyOptions cannot report failure, and skips the failing option only
+	got = Validate{}
+	got.ApplyOptions(WithValidatePortChecked(70000), WithValidateHost("localhost"))
+	if want := (Validate{Host: "localhost"}); got != want {
+		panic(fmt.Sprintf("Validate.go: ApplyOptions(%d): got %+v, want %+v", 70000, got, want))
+	}
+}
@@ -0,0 +1,109 @@
+// Code generated by "go-option -type Validate -validate"; DO NOT EDIT.
+// Install go-option by "go get install github.com/searKing/golang/tools/go-option"
+
+package main
+
+// A ValidateOption sets options.
+type ValidateOption interface {
+	apply(*Validate)
+	// applyCheck applies the option as apply does, and returns an error if the option is invalid,
+	// applied by ApplyOptionsReturnError.
+	applyCheck(*Validate) error
+}
+
+// EmptyValidateOption does not alter the configuration. It can be embedded
+// in another structure to build custom options.
+//
+// This API is EXPERIMENTAL.
+type EmptyValidateOption struct{}
+
+func (EmptyValidateOption) apply(*Validate) {}
+
+func (EmptyValidateOption) applyCheck(*Validate) error { return nil }
+
+// ValidateOptionFunc wraps a function that modifies Validate into an
+// implementation of the ValidateOption interface.
+type ValidateOptionFunc func(*Validate)
+
+func (f ValidateOptionFunc) apply(do *Validate) {
+	f(do)
+}
+
+func (f ValidateOptionFunc) applyCheck(do *Validate) error {
+	f(do)
+	return nil
+}
+
+// ValidateOptionCheckFunc wraps a function that modifies Validate and may fail, such as to validate
+// the value it sets, into an implementation of the ValidateOption interface.
+// ApplyOptionsReturnError returns its error; ApplyOptions, which cannot report failure, ignores it.
+type ValidateOptionCheckFunc func(*Validate) error
+
+func (f ValidateOptionCheckFunc) apply(do *Validate) {
+	_ = f(do)
+}
+
+func (f ValidateOptionCheckFunc) applyCheck(do *Validate) error {
+	return f(do)
+}
+
+// ApplyOptions call apply() for all options one by one
+func (o *Validate) ApplyOptions(options ...ValidateOption) *Validate {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		opt.apply(o)
+	}
+	return o
+}
+
+// ApplyOptionsReturnError call applyCheck() for all options one by one, as ApplyOptions does,
+// and stops at the first option failing, returning its error, the options before it applied already.
+func (o *Validate) ApplyOptionsReturnError(options ...ValidateOption) error {
+	for _, opt := range options {
+		if opt == nil {
+			continue
+		}
+		if err := opt.applyCheck(o); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithValidate sets Validate.
+func WithValidate(v Validate) ValidateOption {
+	return ValidateOptionFunc(func(o *Validate) {
+		*o = v
+	})
+}
+
+// _default_Validate_value is the default of Validate, the zero value but for the fields with a default.
+var _default_Validate_value = func() (val Validate) {
+	return
+}()
+
+// WithValidateDefaults resets Validate to its default,
+// the zero value but for the fields with a default.
+// Options are applied in order, so it discards the effects of the options before it, and the options after it
+// still apply, as ApplyOptions(userOpts..., WithValidateDefaults(), finalOverrides...).
+func WithValidateDefaults() ValidateOption {
+	return ValidateOptionFunc(func(o *Validate) {
+		*o = _default_Validate_value
+	})
+}
+
+// WithValidateHost sets Host in Validate.
+func WithValidateHost(v string) ValidateOption {
+	return ValidateOptionFunc(func(o *Validate) {
+		o.Host = v
+	})
+}
+
+// WithValidatePort sets Port in Validate.
+func WithValidatePort(v int) ValidateOption {
+	return ValidateOptionFunc(func(o *Validate) {
+		o.Port = v
+	})
+}